@@ -9,6 +9,7 @@ require (
 	github.com/hashicorp/go-retryablehttp v0.7.5
 	github.com/hashicorp/go-uuid v1.0.3
 	golang.org/x/oauth2 v0.16.0
+	software.sslmate.com/src/go-pkcs12 v0.4.0
 )
 
 require (
@@ -21,5 +22,4 @@ require (
 	golang.org/x/net v0.20.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	google.golang.org/protobuf v1.31.0 // indirect
-	software.sslmate.com/src/go-pkcs12 v0.4.0 // indirect
 )
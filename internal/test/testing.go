@@ -106,10 +106,13 @@ type Test struct {
 	AttributeSetClient                                      *msgraph.AttributeSetClient
 	AuthenticationMethodsClient                             *msgraph.AuthenticationMethodsClient
 	AuthenticationStrengthPoliciesClient                    *msgraph.AuthenticationStrengthPoliciesClient
+	B2BManagementPolicyClient                               *msgraph.B2BManagementPolicyClient
 	B2CUserFlowClient                                       *msgraph.B2CUserFlowClient
 	ClaimsMappingPolicyClient                               *msgraph.ClaimsMappingPolicyClient
 	ConditionalAccessPoliciesClient                         *msgraph.ConditionalAccessPoliciesClient
 	ConnectedOrganizationClient                             *msgraph.ConnectedOrganizationClient
+	ConnectorGroupsClient                                   *msgraph.ConnectorGroupsClient
+	ConnectorsClient                                        *msgraph.ConnectorsClient
 	CustomSecurityAttributeDefinitionClient                 *msgraph.CustomSecurityAttributeDefinitionClient
 	DelegatedPermissionGrantsClient                         *msgraph.DelegatedPermissionGrantsClient
 	DirectoryAuditReportsClient                             *msgraph.DirectoryAuditReportsClient
@@ -119,9 +122,13 @@ type Test struct {
 	DomainsClient                                           *msgraph.DomainsClient
 	EntitlementRoleAssignmentsClient                        *msgraph.EntitlementRoleAssignmentsClient
 	EntitlementRoleDefinitionsClient                        *msgraph.EntitlementRoleDefinitionsClient
+	ExternalIdentitiesPolicyClient                          *msgraph.ExternalIdentitiesPolicyClient
 	GroupsAppRoleAssignmentsClient                          *msgraph.AppRoleAssignmentsClient
 	GroupsClient                                            *msgraph.GroupsClient
+	GroupsOpenExtensionsClient                              *msgraph.OpenExtensionsClient
+	IdentityApiConnectorsClient                             *msgraph.IdentityApiConnectorsClient
 	IdentityProvidersClient                                 *msgraph.IdentityProvidersClient
+	ImportedWindowsAutopilotDeviceIdentitiesClient          *msgraph.ImportedWindowsAutopilotDeviceIdentitiesClient
 	InvitationsClient                                       *msgraph.InvitationsClient
 	MeClient                                                *msgraph.MeClient
 	NamedLocationsClient                                    *msgraph.NamedLocationsClient
@@ -131,6 +138,7 @@ type Test struct {
 	PrivilegedAccessGroupEligibilityScheduleClient          *msgraph.PrivilegedAccessGroupEligibilityScheduleClient
 	PrivilegedAccessGroupEligibilityScheduleInstancesClient *msgraph.PrivilegedAccessGroupEligibilityScheduleInstancesClient
 	PrivilegedAccessGroupEligibilityScheduleRequestsClient  *msgraph.PrivilegedAccessGroupEligibilityScheduleRequestsClient
+	ProvisioningLogsClient                                  *msgraph.ProvisioningLogsClient
 	ReportsClient                                           *msgraph.ReportsClient
 	RoleAssignmentsClient                                   *msgraph.RoleAssignmentsClient
 	RoleDefinitionsClient                                   *msgraph.RoleDefinitionsClient
@@ -145,10 +153,14 @@ type Test struct {
 	SynchronizationJobClient                                *msgraph.SynchronizationJobClient
 	TermsOfUseAgreementClient                               *msgraph.TermsOfUseAgreementClient
 	TokenIssuancePolicyClient                               *msgraph.TokenIssuancePolicyClient
+	TrustFrameworkKeySetsClient                             *msgraph.TrustFrameworkKeySetsClient
+	TrustFrameworkPoliciesClient                            *msgraph.TrustFrameworkPoliciesClient
 	UserFlowAttributesClient                                *msgraph.UserFlowAttributesClient
 	UsersAppRoleAssignmentsClient                           *msgraph.AppRoleAssignmentsClient
 	UsersClient                                             *msgraph.UsersClient
+	UsersOpenExtensionsClient                               *msgraph.OpenExtensionsClient
 	WindowsAutopilotDeploymentProfilesClient                *msgraph.WindowsAutopilotDeploymentProfilesClient
+	WindowsAutopilotDeviceIdentitiesClient                  *msgraph.WindowsAutopilotDeviceIdentitiesClient
 }
 
 func NewTest(t *testing.T) (c *Test) {
@@ -277,6 +289,11 @@ func NewTest(t *testing.T) (c *Test) {
 	c.AuthenticationStrengthPoliciesClient.BaseClient.Endpoint = *endpoint
 	c.AuthenticationStrengthPoliciesClient.BaseClient.RetryableClient.RetryMax = retry
 
+	c.B2BManagementPolicyClient = msgraph.NewB2BManagementPolicyClient()
+	c.B2BManagementPolicyClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
+	c.B2BManagementPolicyClient.BaseClient.Endpoint = *endpoint
+	c.B2BManagementPolicyClient.BaseClient.RetryableClient.RetryMax = retry
+
 	c.B2CUserFlowClient = msgraph.NewB2CUserFlowClient()
 	c.B2CUserFlowClient.BaseClient.Authorizer = c.Connections["b2c"].Authorizer
 	c.B2CUserFlowClient.BaseClient.Endpoint = *endpoint
@@ -297,6 +314,16 @@ func NewTest(t *testing.T) (c *Test) {
 	c.ConnectedOrganizationClient.BaseClient.Endpoint = *endpoint
 	c.ConnectedOrganizationClient.BaseClient.RetryableClient.RetryMax = retry
 
+	c.ConnectorGroupsClient = msgraph.NewConnectorGroupsClient()
+	c.ConnectorGroupsClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
+	c.ConnectorGroupsClient.BaseClient.Endpoint = *endpoint
+	c.ConnectorGroupsClient.BaseClient.RetryableClient.RetryMax = retry
+
+	c.ConnectorsClient = msgraph.NewConnectorsClient()
+	c.ConnectorsClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
+	c.ConnectorsClient.BaseClient.Endpoint = *endpoint
+	c.ConnectorsClient.BaseClient.RetryableClient.RetryMax = retry
+
 	c.CustomSecurityAttributeDefinitionClient = msgraph.NewCustomSecurityAttributeDefinitionClient()
 	c.CustomSecurityAttributeDefinitionClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
 	c.CustomSecurityAttributeDefinitionClient.BaseClient.Endpoint = *endpoint
@@ -342,6 +369,11 @@ func NewTest(t *testing.T) (c *Test) {
 	c.EntitlementRoleDefinitionsClient.BaseClient.Endpoint = *endpoint
 	c.EntitlementRoleDefinitionsClient.BaseClient.RetryableClient.RetryMax = retry
 
+	c.ExternalIdentitiesPolicyClient = msgraph.NewExternalIdentitiesPolicyClient()
+	c.ExternalIdentitiesPolicyClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
+	c.ExternalIdentitiesPolicyClient.BaseClient.Endpoint = *endpoint
+	c.ExternalIdentitiesPolicyClient.BaseClient.RetryableClient.RetryMax = retry
+
 	c.GroupsAppRoleAssignmentsClient = msgraph.NewGroupsAppRoleAssignmentsClient()
 	c.GroupsAppRoleAssignmentsClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
 	c.GroupsAppRoleAssignmentsClient.BaseClient.Endpoint = *endpoint
@@ -352,11 +384,26 @@ func NewTest(t *testing.T) (c *Test) {
 	c.GroupsClient.BaseClient.Endpoint = *endpoint
 	c.GroupsClient.BaseClient.RetryableClient.RetryMax = retry
 
+	c.GroupsOpenExtensionsClient = msgraph.NewGroupsOpenExtensionsClient()
+	c.GroupsOpenExtensionsClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
+	c.GroupsOpenExtensionsClient.BaseClient.Endpoint = *endpoint
+	c.GroupsOpenExtensionsClient.BaseClient.RetryableClient.RetryMax = retry
+
+	c.IdentityApiConnectorsClient = msgraph.NewIdentityApiConnectorsClient()
+	c.IdentityApiConnectorsClient.BaseClient.Authorizer = c.Connections["b2c"].Authorizer
+	c.IdentityApiConnectorsClient.BaseClient.Endpoint = *endpoint
+	c.IdentityApiConnectorsClient.BaseClient.RetryableClient.RetryMax = retry
+
 	c.IdentityProvidersClient = msgraph.NewIdentityProvidersClient()
 	c.IdentityProvidersClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
 	c.IdentityProvidersClient.BaseClient.Endpoint = *endpoint
 	c.IdentityProvidersClient.BaseClient.RetryableClient.RetryMax = retry
 
+	c.ImportedWindowsAutopilotDeviceIdentitiesClient = msgraph.NewImportedWindowsAutopilotDeviceIdentitiesClient()
+	c.ImportedWindowsAutopilotDeviceIdentitiesClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
+	c.ImportedWindowsAutopilotDeviceIdentitiesClient.BaseClient.Endpoint = *endpoint
+	c.ImportedWindowsAutopilotDeviceIdentitiesClient.BaseClient.RetryableClient.RetryMax = retry
+
 	c.InvitationsClient = msgraph.NewInvitationsClient()
 	c.InvitationsClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
 	c.InvitationsClient.BaseClient.Endpoint = *endpoint
@@ -402,6 +449,11 @@ func NewTest(t *testing.T) (c *Test) {
 	c.PrivilegedAccessGroupEligibilityScheduleRequestsClient.BaseClient.Endpoint = *endpoint
 	c.PrivilegedAccessGroupEligibilityScheduleRequestsClient.BaseClient.RetryableClient.RetryMax = retry
 
+	c.ProvisioningLogsClient = msgraph.NewProvisioningLogsClient()
+	c.ProvisioningLogsClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
+	c.ProvisioningLogsClient.BaseClient.Endpoint = *endpoint
+	c.ProvisioningLogsClient.BaseClient.RetryableClient.RetryMax = retry
+
 	c.ReportsClient = msgraph.NewReportsClient()
 	c.ReportsClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
 	c.ReportsClient.BaseClient.Endpoint = *endpoint
@@ -473,6 +525,16 @@ func NewTest(t *testing.T) (c *Test) {
 	c.TokenIssuancePolicyClient.BaseClient.Endpoint = *endpoint
 	c.TokenIssuancePolicyClient.BaseClient.RetryableClient.RetryMax = retry
 
+	c.TrustFrameworkKeySetsClient = msgraph.NewTrustFrameworkKeySetsClient()
+	c.TrustFrameworkKeySetsClient.BaseClient.Authorizer = c.Connections["b2c"].Authorizer
+	c.TrustFrameworkKeySetsClient.BaseClient.Endpoint = *endpoint
+	c.TrustFrameworkKeySetsClient.BaseClient.RetryableClient.RetryMax = retry
+
+	c.TrustFrameworkPoliciesClient = msgraph.NewTrustFrameworkPoliciesClient()
+	c.TrustFrameworkPoliciesClient.BaseClient.Authorizer = c.Connections["b2c"].Authorizer
+	c.TrustFrameworkPoliciesClient.BaseClient.Endpoint = *endpoint
+	c.TrustFrameworkPoliciesClient.BaseClient.RetryableClient.RetryMax = retry
+
 	c.UserFlowAttributesClient = msgraph.NewUserFlowAttributesClient()
 	c.UserFlowAttributesClient.BaseClient.Authorizer = c.Connections["b2c"].Authorizer
 	c.UserFlowAttributesClient.BaseClient.Endpoint = *endpoint
@@ -488,10 +550,20 @@ func NewTest(t *testing.T) (c *Test) {
 	c.UsersClient.BaseClient.Endpoint = *endpoint
 	c.UsersClient.BaseClient.RetryableClient.RetryMax = retry
 
+	c.UsersOpenExtensionsClient = msgraph.NewUsersOpenExtensionsClient()
+	c.UsersOpenExtensionsClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
+	c.UsersOpenExtensionsClient.BaseClient.Endpoint = *endpoint
+	c.UsersOpenExtensionsClient.BaseClient.RetryableClient.RetryMax = retry
+
 	c.WindowsAutopilotDeploymentProfilesClient = msgraph.NewWindowsAutopilotDeploymentProfilesClient()
 	c.WindowsAutopilotDeploymentProfilesClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
 	c.WindowsAutopilotDeploymentProfilesClient.BaseClient.Endpoint = *endpoint
 	c.WindowsAutopilotDeploymentProfilesClient.BaseClient.RetryableClient.RetryMax = retry
 
+	c.WindowsAutopilotDeviceIdentitiesClient = msgraph.NewWindowsAutopilotDeviceIdentitiesClient()
+	c.WindowsAutopilotDeviceIdentitiesClient.BaseClient.Authorizer = c.Connections["default"].Authorizer
+	c.WindowsAutopilotDeviceIdentitiesClient.BaseClient.Endpoint = *endpoint
+	c.WindowsAutopilotDeviceIdentitiesClient.BaseClient.RetryableClient.RetryMax = retry
+
 	return
 }
@@ -0,0 +1,385 @@
+package msgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+// AdminConsentReport describes the changes made, or which would be made when performing a dry run, when granting or
+// revoking admin consent for an application.
+type AdminConsentReport struct {
+	AppId                    string               `json:"appId"`
+	ClientServicePrincipalId string               `json:"clientServicePrincipalId,omitempty"`
+	CreatedServicePrincipals []string             `json:"createdServicePrincipals"`
+	Permissions              []AdminConsentChange `json:"permissions"`
+	UnresolvedResourceAccess []ResourceAccess     `json:"unresolvedResourceAccess"`
+	DryRun                   bool                 `json:"dryRun"`
+}
+
+// AdminConsentChange describes the change made to a single app role assignment or delegated permission scope.
+type AdminConsentChange struct {
+	ResourceAppId              string             `json:"resourceAppId"`
+	ResourceServicePrincipalId string             `json:"resourceServicePrincipalId,omitempty"`
+	Type                       ResourceAccessType `json:"type"`
+	ID                         string             `json:"id"`
+	Value                      string             `json:"value,omitempty"`
+	Action                     AdminConsentAction `json:"action"`
+}
+
+// HasChanges returns true when any permission was added or removed.
+func (r AdminConsentReport) HasChanges() bool {
+	if len(r.CreatedServicePrincipals) > 0 {
+		return true
+	}
+	for _, p := range r.Permissions {
+		if p.Action != AdminConsentActionUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// AdminConsentManager grants and revokes tenant-wide admin consent for the permissions requested by an application in
+// its RequiredResourceAccess. App roles are granted with app role assignments for the client service principal, and
+// delegated permission scopes are granted with a DelegatedPermissionGrant having a consent type of AllPrincipals.
+type AdminConsentManager struct {
+	ServicePrincipalsClient         *ServicePrincipalsClient
+	DelegatedPermissionGrantsClient *DelegatedPermissionGrantsClient
+}
+
+// NewAdminConsentManager returns a new AdminConsentManager using the provided clients.
+func NewAdminConsentManager(servicePrincipalsClient *ServicePrincipalsClient, delegatedPermissionGrantsClient *DelegatedPermissionGrantsClient) *AdminConsentManager {
+	return &AdminConsentManager{
+		ServicePrincipalsClient:         servicePrincipalsClient,
+		DelegatedPermissionGrantsClient: delegatedPermissionGrantsClient,
+	}
+}
+
+// GrantAdminConsent grants admin consent for all permissions in the RequiredResourceAccess of the provided application.
+// Service principals are created for the application and for each resource when they do not already exist. Missing app
+// role assignments are created, and missing scopes are merged into any existing tenant-wide delegated permission grant.
+// Existing assignments and scopes are left unchanged, so it is safe to call repeatedly. When dryRun is true, no changes
+// are made and the returned report describes the changes that would be made.
+func (m *AdminConsentManager) GrantAdminConsent(ctx context.Context, application Application, dryRun bool) (*AdminConsentReport, int, error) {
+	return m.reconcile(ctx, application, dryRun, false)
+}
+
+// RevokeAdminConsent revokes admin consent for all permissions in the RequiredResourceAccess of the provided
+// application, removing the corresponding app role assignments and delegated permission scopes. A tenant-wide delegated
+// permission grant is deleted when no scopes remain. Service principals are not deleted. When dryRun is true, no
+// changes are made and the returned report describes the changes that would be made.
+func (m *AdminConsentManager) RevokeAdminConsent(ctx context.Context, application Application, dryRun bool) (*AdminConsentReport, int, error) {
+	return m.reconcile(ctx, application, dryRun, true)
+}
+
+func (m *AdminConsentManager) reconcile(ctx context.Context, application Application, dryRun, revoke bool) (*AdminConsentReport, int, error) {
+	if m.ServicePrincipalsClient == nil {
+		return nil, 0, errors.New("cannot manage admin consent with nil ServicePrincipalsClient")
+	}
+	if m.DelegatedPermissionGrantsClient == nil {
+		return nil, 0, errors.New("cannot manage admin consent with nil DelegatedPermissionGrantsClient")
+	}
+	if application.AppId == nil || *application.AppId == "" {
+		return nil, 0, errors.New("cannot manage admin consent for application with empty AppId")
+	}
+
+	report := &AdminConsentReport{
+		AppId:                    *application.AppId,
+		CreatedServicePrincipals: make([]string, 0),
+		Permissions:              make([]AdminConsentChange, 0),
+		UnresolvedResourceAccess: make([]ResourceAccess, 0),
+		DryRun:                   dryRun,
+	}
+
+	clientServicePrincipal, status, err := m.servicePrincipal(ctx, report, *application.AppId, !revoke)
+	if err != nil {
+		return nil, status, err
+	}
+	if clientServicePrincipal == nil {
+		// Nothing has been consented for an application without a service principal
+		return report, status, nil
+	}
+	if clientServicePrincipal.ID() != nil {
+		report.ClientServicePrincipalId = *clientServicePrincipal.ID()
+	}
+
+	var existingAssignments []AppRoleAssignment
+	if report.ClientServicePrincipalId != "" {
+		appRoleAssignmentsClient := &AppRoleAssignmentsClient{
+			BaseClient:   m.ServicePrincipalsClient.BaseClient,
+			resourceType: servicePrincipalsAppRoleAssignmentsResource,
+		}
+		assignments, status, err := appRoleAssignmentsClient.List(ctx, report.ClientServicePrincipalId, odata.Query{})
+		if err != nil {
+			return nil, status, fmt.Errorf("listing app role assignments for client service principal: %v", err)
+		}
+		if assignments != nil {
+			existingAssignments = *assignments
+		}
+	}
+
+	if application.RequiredResourceAccess == nil {
+		return report, status, nil
+	}
+
+	for _, rra := range *application.RequiredResourceAccess {
+		if rra.ResourceAppId == nil || rra.ResourceAccess == nil || len(*rra.ResourceAccess) == 0 {
+			continue
+		}
+
+		resourceServicePrincipal, status, err := m.servicePrincipal(ctx, report, *rra.ResourceAppId, !revoke)
+		if err != nil {
+			return nil, status, err
+		}
+		if resourceServicePrincipal == nil {
+			continue
+		}
+
+		var resourceId string
+		if resourceServicePrincipal.ID() != nil {
+			resourceId = *resourceServicePrincipal.ID()
+		}
+		permissions := newResourceAccessPermissions(*resourceServicePrincipal)
+
+		roleIds := make([]string, 0)
+		scopes := make([]string, 0)
+		for _, ra := range *rra.ResourceAccess {
+			if ra.ID == nil {
+				continue
+			}
+			resolved, ok := permissions.byId[resourceAccessKey(ra.Type, *ra.ID)]
+			if !ok {
+				report.UnresolvedResourceAccess = append(report.UnresolvedResourceAccess, ra)
+				continue
+			}
+			switch ra.Type {
+			case ResourceAccessTypeRole:
+				roleIds = append(roleIds, resolved.ID)
+			case ResourceAccessTypeScope:
+				scopes = append(scopes, resolved.Value)
+			}
+		}
+
+		newChange := func(accessType ResourceAccessType, id, value string, action AdminConsentAction) AdminConsentChange {
+			if value == "" {
+				value = permissions.byId[resourceAccessKey(accessType, id)].Value
+			}
+			if id == "" {
+				id = permissions.byValue[resourceAccessKey(accessType, value)].ID
+			}
+			return AdminConsentChange{
+				ResourceAppId:              *rra.ResourceAppId,
+				ResourceServicePrincipalId: resourceId,
+				Type:                       accessType,
+				ID:                         id,
+				Value:                      value,
+				Action:                     action,
+			}
+		}
+
+		// App roles
+		toAdd, toRemove, unchanged := diffAppRoleAssignments(roleIds, resourceId, existingAssignments, revoke)
+		for _, roleId := range unchanged {
+			report.Permissions = append(report.Permissions, newChange(ResourceAccessTypeRole, roleId, "", AdminConsentActionUnchanged))
+		}
+		for _, roleId := range toAdd {
+			if !dryRun {
+				if _, status, err := m.ServicePrincipalsClient.AssignAppRoleForResource(ctx, report.ClientServicePrincipalId, resourceId, roleId); err != nil {
+					return nil, status, fmt.Errorf("assigning app role %q for resource with app ID %q: %v", roleId, *rra.ResourceAppId, err)
+				}
+			}
+			report.Permissions = append(report.Permissions, newChange(ResourceAccessTypeRole, roleId, "", AdminConsentActionAdded))
+		}
+		for _, assignment := range toRemove {
+			if !dryRun {
+				if status, err := m.ServicePrincipalsClient.RemoveAppRoleAssignment(ctx, resourceId, *assignment.Id); err != nil {
+					return nil, status, fmt.Errorf("removing app role assignment %q for resource with app ID %q: %v", *assignment.Id, *rra.ResourceAppId, err)
+				}
+			}
+			report.Permissions = append(report.Permissions, newChange(ResourceAccessTypeRole, *assignment.AppRoleId, "", AdminConsentActionRemoved))
+		}
+
+		// Delegated permission scopes
+		if len(scopes) == 0 {
+			continue
+		}
+
+		var grant *DelegatedPermissionGrant
+		if report.ClientServicePrincipalId != "" && resourceId != "" {
+			grants, status, err := m.DelegatedPermissionGrantsClient.List(ctx, odata.Query{
+				Filter: fmt.Sprintf("clientId eq '%s' and resourceId eq '%s' and consentType eq '%s'", report.ClientServicePrincipalId, resourceId, DelegatedPermissionGrantConsentTypeAllPrincipals),
+			})
+			if err != nil {
+				return nil, status, fmt.Errorf("listing delegated permission grants for resource with app ID %q: %v", *rra.ResourceAppId, err)
+			}
+			if grants != nil && len(*grants) > 0 {
+				grant = &(*grants)[0]
+			}
+		}
+
+		var existingScopes []string
+		if grant != nil && grant.Scopes != nil {
+			existingScopes = *grant.Scopes
+		}
+
+		result, changed, unchangedScopes := diffDelegatedScopes(scopes, existingScopes, revoke)
+		for _, scope := range unchangedScopes {
+			report.Permissions = append(report.Permissions, newChange(ResourceAccessTypeScope, "", scope, AdminConsentActionUnchanged))
+		}
+		action := AdminConsentActionAdded
+		if revoke {
+			action = AdminConsentActionRemoved
+		}
+		for _, scope := range changed {
+			report.Permissions = append(report.Permissions, newChange(ResourceAccessTypeScope, "", scope, action))
+		}
+
+		if len(changed) == 0 || dryRun {
+			continue
+		}
+
+		switch {
+		case grant == nil:
+			if _, status, err := m.DelegatedPermissionGrantsClient.Create(ctx, DelegatedPermissionGrant{
+				ClientId:    utils.StringPtr(report.ClientServicePrincipalId),
+				ConsentType: utils.StringPtr(DelegatedPermissionGrantConsentTypeAllPrincipals),
+				ResourceId:  utils.StringPtr(resourceId),
+				Scopes:      &result,
+			}); err != nil {
+				return nil, status, fmt.Errorf("creating delegated permission grant for resource with app ID %q: %v", *rra.ResourceAppId, err)
+			}
+
+		case len(result) == 0:
+			if status, err := m.DelegatedPermissionGrantsClient.Delete(ctx, *grant.Id); err != nil {
+				return nil, status, fmt.Errorf("deleting delegated permission grant for resource with app ID %q: %v", *rra.ResourceAppId, err)
+			}
+
+		default:
+			if status, err := m.DelegatedPermissionGrantsClient.Update(ctx, DelegatedPermissionGrant{
+				Id:     grant.Id,
+				Scopes: &result,
+			}); err != nil {
+				return nil, status, fmt.Errorf("updating delegated permission grant for resource with app ID %q: %v", *rra.ResourceAppId, err)
+			}
+		}
+	}
+
+	return report, status, nil
+}
+
+// servicePrincipal returns the service principal for the specified application ID. When create is true and no service
+// principal exists, one is created (unless performing a dry run), otherwise nil is returned.
+func (m *AdminConsentManager) servicePrincipal(ctx context.Context, report *AdminConsentReport, appId string, create bool) (*ServicePrincipal, int, error) {
+	servicePrincipals, status, err := m.ServicePrincipalsClient.List(ctx, odata.Query{
+		Filter: fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(appId)),
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("retrieving service principal for app ID %q: %v", appId, err)
+	}
+	if servicePrincipals != nil && len(*servicePrincipals) > 0 {
+		return &(*servicePrincipals)[0], status, nil
+	}
+	if !create {
+		return nil, status, nil
+	}
+
+	report.CreatedServicePrincipals = append(report.CreatedServicePrincipals, appId)
+	if report.DryRun {
+		// Return a placeholder so that the remaining changes can still be reported
+		return &ServicePrincipal{AppId: utils.StringPtr(appId)}, status, nil
+	}
+
+	servicePrincipal, status, err := m.ServicePrincipalsClient.Create(ctx, ServicePrincipal{
+		AppId: utils.StringPtr(appId),
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("creating service principal for app ID %q: %v", appId, err)
+	}
+
+	return servicePrincipal, status, nil
+}
+
+// diffAppRoleAssignments compares the desired app role IDs for a resource with the existing app role assignments for
+// the client service principal. When granting, the app role IDs to assign are returned. When revoking, the existing
+// assignments to remove are returned.
+func diffAppRoleAssignments(roleIds []string, resourceId string, existing []AppRoleAssignment, revoke bool) (toAdd []string, toRemove []AppRoleAssignment, unchanged []string) {
+	assigned := make(map[string]AppRoleAssignment)
+	for _, assignment := range existing {
+		if assignment.ResourceId == nil || assignment.AppRoleId == nil || !strings.EqualFold(*assignment.ResourceId, resourceId) {
+			continue
+		}
+		assigned[strings.ToLower(*assignment.AppRoleId)] = assignment
+	}
+
+	seen := make(map[string]bool)
+	for _, roleId := range roleIds {
+		key := strings.ToLower(roleId)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		assignment, ok := assigned[key]
+		switch {
+		case revoke && ok && assignment.Id != nil:
+			toRemove = append(toRemove, assignment)
+		case !revoke && !ok:
+			toAdd = append(toAdd, roleId)
+		default:
+			unchanged = append(unchanged, roleId)
+		}
+	}
+
+	return
+}
+
+// diffDelegatedScopes compares the desired scopes with those in an existing delegated permission grant. It returns the
+// resulting scopes for the grant, along with the scopes which were added (or removed, when revoking) and those which
+// were unchanged. Scopes are compared case-insensitively, and the order of existing scopes is preserved.
+func diffDelegatedScopes(scopes, existing []string, revoke bool) (result, changed, unchanged []string) {
+	existingSet := make(map[string]bool)
+	for _, scope := range existing {
+		existingSet[strings.ToLower(scope)] = true
+	}
+	desiredSet := make(map[string]bool)
+	for _, scope := range scopes {
+		desiredSet[strings.ToLower(scope)] = true
+	}
+
+	result = make([]string, 0, len(existing)+len(scopes))
+	if revoke {
+		for _, scope := range existing {
+			if !desiredSet[strings.ToLower(scope)] {
+				result = append(result, scope)
+			}
+		}
+	} else {
+		result = append(result, existing...)
+	}
+
+	seen := make(map[string]bool)
+	for _, scope := range scopes {
+		key := strings.ToLower(scope)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		switch {
+		case revoke && existingSet[key]:
+			changed = append(changed, scope)
+		case !revoke && !existingSet[key]:
+			changed = append(changed, scope)
+			result = append(result, scope)
+		default:
+			unchanged = append(unchanged, scope)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,69 @@
+package msgraph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+func TestDiffAppRoleAssignments(t *testing.T) {
+	existing := []AppRoleAssignment{
+		{Id: utils.StringPtr("assignment-1"), AppRoleId: utils.StringPtr("ROLE-1"), ResourceId: utils.StringPtr("resource-1")},
+		{Id: utils.StringPtr("assignment-2"), AppRoleId: utils.StringPtr("role-2"), ResourceId: utils.StringPtr("resource-2")},
+	}
+	roleIds := []string{"role-1", "role-2", "role-1"}
+
+	toAdd, toRemove, unchanged := diffAppRoleAssignments(roleIds, "resource-1", existing, false)
+	if !reflect.DeepEqual(toAdd, []string{"role-2"}) {
+		t.Errorf("unexpected roles to add when granting: %v", toAdd)
+	}
+	if len(toRemove) != 0 {
+		t.Errorf("expected no assignments to remove when granting, got %d", len(toRemove))
+	}
+	if !reflect.DeepEqual(unchanged, []string{"role-1"}) {
+		t.Errorf("unexpected unchanged roles when granting: %v", unchanged)
+	}
+
+	toAdd, toRemove, unchanged = diffAppRoleAssignments(roleIds, "resource-1", existing, true)
+	if len(toAdd) != 0 {
+		t.Errorf("expected no roles to add when revoking, got %v", toAdd)
+	}
+	if len(toRemove) != 1 || *toRemove[0].Id != "assignment-1" {
+		t.Errorf("unexpected assignments to remove when revoking: %v", toRemove)
+	}
+	if !reflect.DeepEqual(unchanged, []string{"role-2"}) {
+		t.Errorf("unexpected unchanged roles when revoking: %v", unchanged)
+	}
+}
+
+func TestDiffDelegatedScopes(t *testing.T) {
+	existing := []string{"openid", "User.Read"}
+
+	result, changed, unchanged := diffDelegatedScopes([]string{"user.read", "Mail.Read"}, existing, false)
+	if !reflect.DeepEqual(result, []string{"openid", "User.Read", "Mail.Read"}) {
+		t.Errorf("unexpected scopes when granting: %v", result)
+	}
+	if !reflect.DeepEqual(changed, []string{"Mail.Read"}) {
+		t.Errorf("unexpected added scopes when granting: %v", changed)
+	}
+	if !reflect.DeepEqual(unchanged, []string{"user.read"}) {
+		t.Errorf("unexpected unchanged scopes when granting: %v", unchanged)
+	}
+
+	// Granting again with the merged result is a no-op
+	if _, changed, _ = diffDelegatedScopes([]string{"user.read", "Mail.Read"}, result, false); len(changed) != 0 {
+		t.Errorf("expected granting to be idempotent, got changes: %v", changed)
+	}
+
+	result, changed, unchanged = diffDelegatedScopes([]string{"User.Read", "Mail.Read"}, existing, true)
+	if !reflect.DeepEqual(result, []string{"openid"}) {
+		t.Errorf("unexpected scopes when revoking: %v", result)
+	}
+	if !reflect.DeepEqual(changed, []string{"User.Read"}) {
+		t.Errorf("unexpected removed scopes when revoking: %v", changed)
+	}
+	if !reflect.DeepEqual(unchanged, []string{"Mail.Read"}) {
+		t.Errorf("unexpected unchanged scopes when revoking: %v", unchanged)
+	}
+}
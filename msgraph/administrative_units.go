@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 )
@@ -199,6 +200,36 @@ func (c *AdministrativeUnitsClient) ListMembers(ctx context.Context, administrat
 	return &ret, status, nil
 }
 
+// ListMemberEntities retrieves the members of the specified administrative unit, optionally queried using OData.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *AdministrativeUnitsClient) ListMemberEntities(ctx context.Context, administrativeUnitId string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/administrativeUnits/%s/members", administrativeUnitId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AdministrativeUnitsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
 // GetMember retrieves a single member of the specified AdministrativeUnit.
 func (c *AdministrativeUnitsClient) GetMember(ctx context.Context, administrativeUnitId, memberId string) (*string, int, error) {
 	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
@@ -460,3 +491,122 @@ func (c *AdministrativeUnitsClient) RemoveScopedRoleMembers(ctx context.Context,
 
 	return status, nil
 }
+
+// EvaluateDynamicMembership evaluates whether a user or device is, or would be, a member of a dynamic AdministrativeUnit.
+func (c *AdministrativeUnitsClient) EvaluateDynamicMembership(ctx context.Context, administrativeUnitId, memberId string) (*EvaluateDynamicMembershipResult, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		MemberId string `json:"memberId"`
+	}{
+		MemberId: memberId,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/administrativeUnits/%s/evaluateDynamicMembership", administrativeUnitId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AdministrativeUnitsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var result EvaluateDynamicMembershipResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &result, status, nil
+}
+
+// EvaluateDynamicMembershipRule evaluates a membership rule against a user or device, without the rule having to be
+// saved to an AdministrativeUnit.
+func (c *AdministrativeUnitsClient) EvaluateDynamicMembershipRule(ctx context.Context, memberId, membershipRule string) (*EvaluateDynamicMembershipResult, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		MemberId       string `json:"memberId"`
+		MembershipRule string `json:"membershipRule"`
+	}{
+		MemberId:       memberId,
+		MembershipRule: membershipRule,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/administrativeUnits/evaluateDynamicMembership",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AdministrativeUnitsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var result EvaluateDynamicMembershipResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &result, status, nil
+}
+
+// WaitForMembershipRuleProcessing polls a dynamic AdministrativeUnit every interval until its membership rule processing
+// status is Succeeded, returning the AdministrativeUnit with its latest processing status. An error is returned if
+// processing fails, or if the context is cancelled or reaches its deadline before processing has completed.
+func (c *AdministrativeUnitsClient) WaitForMembershipRuleProcessing(ctx context.Context, id string, interval time.Duration) (*AdministrativeUnit, int, error) {
+	if interval <= 0 {
+		return nil, 0, fmt.Errorf("cannot wait for membership rule processing with non-positive interval %s", interval)
+	}
+
+	query := odata.Query{
+		Select: []string{"id", "displayName", "membershipRule", "membershipRuleProcessingState", "membershipRuleProcessingStatus", "membershipType"},
+	}
+
+	for {
+		administrativeUnit, status, err := c.Get(ctx, id, query)
+		if err != nil {
+			return nil, status, err
+		}
+
+		if administrativeUnit.MembershipRuleProcessingStatus != nil && administrativeUnit.MembershipRuleProcessingStatus.Status != nil {
+			switch *administrativeUnit.MembershipRuleProcessingStatus.Status {
+			case MembershipRuleProcessingStatusDetailsSucceeded:
+				return administrativeUnit, status, nil
+			case MembershipRuleProcessingStatusDetailsFailed:
+				var errorMessage string
+				if administrativeUnit.MembershipRuleProcessingStatus.ErrorMessage != nil {
+					errorMessage = *administrativeUnit.MembershipRuleProcessingStatus.ErrorMessage
+				}
+				return administrativeUnit, status, fmt.Errorf("membership rule processing failed for administrative unit %q: %s", id, errorMessage)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return administrativeUnit, status, fmt.Errorf("waiting for membership rule processing for administrative unit %q: %v", id, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package msgraph_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/manicminer/hamilton/internal/test"
@@ -37,6 +39,7 @@ func TestAdministrativeUnitsClient(t *testing.T) {
 		},
 	})
 
+	testAdministrativeUnitsClient_EvaluateDynamicMembershipRule(t, c, *user.ID(), fmt.Sprintf(`(user.mailNickname -eq "test-user-%s")`, c.RandomString))
 	testAdministrativeUnitsClient_AddMembers(t, c, *administrativeUnit.ID, &msgraph.Members{user.DirectoryObject})
 	testAdministrativeUnitsClient_ListMembers(t, c, *administrativeUnit.ID)
 	testAdministrativeUnitsClient_GetMember(t, c, *administrativeUnit.ID, *user.ID())
@@ -73,11 +76,27 @@ func TestAdministrativeUnitsClient(t *testing.T) {
 	testAdministrativeUnitsClient_GetRoleScopedMember(t, c, *administrativeUnit.ID, *membership.Id)
 	testAdministrativeUnitsClient_RemoveScopedRoleMember(t, c, *administrativeUnit.ID, *membership.Id)
 
+	dynamicAdministrativeUnit := testAdministrativeUnitsClient_Create(t, c, msgraph.AdministrativeUnit{
+		DisplayName:                   utils.StringPtr(fmt.Sprintf("test-administrativeUnit-dynamic-%s", c.RandomString)),
+		MembershipRule:                msgraph.NullableString(msgraph.StringNullWhenEmpty(fmt.Sprintf(`(user.mailNickname -eq "test-user-%s")`, c.RandomString))),
+		MembershipRuleProcessingState: utils.StringPtr(msgraph.AdministrativeUnitMembershipRuleProcessingStateOn),
+		MembershipType:                utils.StringPtr(msgraph.AdministrativeUnitMembershipTypeDynamic),
+	})
+	testAdministrativeUnitsClient_WaitForMembershipRuleProcessing(t, c, *dynamicAdministrativeUnit.ID)
+	testAdministrativeUnitsClient_Delete(t, c, *dynamicAdministrativeUnit.ID)
+
 	testAdministrativeUnitsClient_List(t, c)
 	testAdministrativeUnitsClient_Delete(t, c, *administrativeUnit.ID)
 	testUsersClient_Delete(t, c, *user.ID())
 }
 
+func TestAdministrativeUnitsClient_WaitForMembershipRuleProcessingInterval(t *testing.T) {
+	client := msgraph.NewAdministrativeUnitsClient()
+	if _, _, err := client.WaitForMembershipRuleProcessing(context.Background(), "11111111-1111-1111-1111-111111111111", 0); err == nil {
+		t.Fatal("AdministrativeUnitsClient.WaitForMembershipRuleProcessing(): expected error for zero interval")
+	}
+}
+
 func testAdministrativeUnitsClient_Create(t *testing.T, c *test.Test, g msgraph.AdministrativeUnit) (administrativeUnit *msgraph.AdministrativeUnit) {
 	administrativeUnit, status, err := c.AdministrativeUnitsClient.Create(c.Context, g)
 	if err != nil {
@@ -253,3 +272,31 @@ func testAdministrativeUnitsClient_RemoveScopedRoleMember(t *testing.T, c *test.
 		t.Fatalf("AdministrativeUnitsClient.RemoveScopedRoleMembers(): invalid status: %d", status)
 	}
 }
+
+func testAdministrativeUnitsClient_EvaluateDynamicMembershipRule(t *testing.T, c *test.Test, memberId, membershipRule string) (result *msgraph.EvaluateDynamicMembershipResult) {
+	result, status, err := c.AdministrativeUnitsClient.EvaluateDynamicMembershipRule(c.Context, memberId, membershipRule)
+	if err != nil {
+		t.Fatalf("AdministrativeUnitsClient.EvaluateDynamicMembershipRule(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("AdministrativeUnitsClient.EvaluateDynamicMembershipRule(): invalid status: %d", status)
+	}
+	if result == nil {
+		t.Fatal("AdministrativeUnitsClient.EvaluateDynamicMembershipRule(): result was nil")
+	}
+	return
+}
+
+func testAdministrativeUnitsClient_WaitForMembershipRuleProcessing(t *testing.T, c *test.Test, id string) (administrativeUnit *msgraph.AdministrativeUnit) {
+	administrativeUnit, status, err := c.AdministrativeUnitsClient.WaitForMembershipRuleProcessing(c.Context, id, 10*time.Second)
+	if err != nil {
+		t.Fatalf("AdministrativeUnitsClient.WaitForMembershipRuleProcessing(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("AdministrativeUnitsClient.WaitForMembershipRuleProcessing(): invalid status: %d", status)
+	}
+	if administrativeUnit == nil {
+		t.Fatal("AdministrativeUnitsClient.WaitForMembershipRuleProcessing(): administrativeUnit was nil")
+	}
+	return
+}
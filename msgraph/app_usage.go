@@ -0,0 +1,326 @@
+package msgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// AppUsageReport describes the sign-in activity, assignees and granted permissions of the enterprise applications in a
+// tenant, to help identify applications which are no longer in use.
+type AppUsageReport struct {
+	GeneratedDateTime     time.Time             `json:"generatedDateTime"`
+	InactiveSinceDateTime time.Time             `json:"inactiveSinceDateTime"`
+	Apps                  []AppUsageReportEntry `json:"apps"`
+}
+
+// AppUsageReportEntry describes the usage of a single enterprise application. An application is unused when it has no
+// recorded sign-in activity, for itself or for any of its credentials, since the InactiveSinceDateTime of the report.
+type AppUsageReportEntry struct {
+	ServicePrincipalId            string               `json:"servicePrincipalId"`
+	AppId                         string               `json:"appId"`
+	DisplayName                   string               `json:"displayName"`
+	LastSignInDateTime            *time.Time           `json:"lastSignInDateTime,omitempty"`
+	LastDelegatedSignInDateTime   *time.Time           `json:"lastDelegatedSignInDateTime,omitempty"`
+	LastApplicationSignInDateTime *time.Time           `json:"lastApplicationSignInDateTime,omitempty"`
+	LastCredentialSignInDateTime  *time.Time           `json:"lastCredentialSignInDateTime,omitempty"`
+	Assignees                     []AppUsageAssignee   `json:"assignees"`
+	Permissions                   []AppUsagePermission `json:"permissions"`
+	Unused                        bool                 `json:"unused"`
+}
+
+// AppUsageAssignee describes a user, group or service principal assigned to an enterprise application. AppRoleValue is
+// empty for assignments of the default access role.
+type AppUsageAssignee struct {
+	PrincipalId   string `json:"principalId"`
+	PrincipalType string `json:"principalType,omitempty"`
+	DisplayName   string `json:"displayName,omitempty"`
+	AppRoleId     string `json:"appRoleId"`
+	AppRoleValue  string `json:"appRoleValue,omitempty"`
+}
+
+// AppUsagePermission describes an app role (application permission) or delegated permission scope granted to an
+// enterprise application. ConsentType is only set for delegated permissions.
+type AppUsagePermission struct {
+	ResourceId          string                              `json:"resourceId"`
+	ResourceDisplayName string                              `json:"resourceDisplayName,omitempty"`
+	Type                ResourceAccessType                  `json:"type"`
+	ID                  string                              `json:"id,omitempty"`
+	Value               string                              `json:"value,omitempty"`
+	ConsentType         DelegatedPermissionGrantConsentType `json:"consentType,omitempty"`
+}
+
+// AppUsageReporter combines the service principal and credential sign-in activity reports with the app role
+// assignments and delegated permission grants of enterprise applications. The sign-in activity reports are only
+// available in the beta API and require the AuditLog.Read.All permission.
+type AppUsageReporter struct {
+	ReportsClient                   *ReportsClient
+	ServicePrincipalsClient         *ServicePrincipalsClient
+	DelegatedPermissionGrantsClient *DelegatedPermissionGrantsClient
+}
+
+// NewAppUsageReporter returns a new AppUsageReporter using the provided clients.
+func NewAppUsageReporter(reportsClient *ReportsClient, servicePrincipalsClient *ServicePrincipalsClient, delegatedPermissionGrantsClient *DelegatedPermissionGrantsClient) *AppUsageReporter {
+	return &AppUsageReporter{
+		ReportsClient:                   reportsClient,
+		ServicePrincipalsClient:         servicePrincipalsClient,
+		DelegatedPermissionGrantsClient: delegatedPermissionGrantsClient,
+	}
+}
+
+// Report pages through every enterprise application, returning its most recent sign-in activity along with its
+// assignees and granted permissions. Applications without sign-in activity within the inactivity period are marked as
+// unused and sorted first. Service principals for managed identities and for applications published by Microsoft are
+// excluded.
+func (r *AppUsageReporter) Report(ctx context.Context, inactivityPeriod time.Duration) (*AppUsageReport, int, error) {
+	if r.ReportsClient == nil {
+		return nil, 0, errors.New("cannot report on app usage with nil ReportsClient")
+	}
+	if r.ServicePrincipalsClient == nil {
+		return nil, 0, errors.New("cannot report on app usage with nil ServicePrincipalsClient")
+	}
+	if r.DelegatedPermissionGrantsClient == nil {
+		return nil, 0, errors.New("cannot report on app usage with nil DelegatedPermissionGrantsClient")
+	}
+
+	now := time.Now()
+	report := &AppUsageReport{
+		GeneratedDateTime:     now,
+		InactiveSinceDateTime: now.Add(-inactivityPeriod),
+		Apps:                  make([]AppUsageReportEntry, 0),
+	}
+
+	signInActivities, status, err := r.ReportsClient.ListServicePrincipalSignInActivities(ctx, odata.Query{})
+	if err != nil {
+		return nil, status, fmt.Errorf("listing service principal sign-in activities: %v", err)
+	}
+	signInActivitiesByAppId := make(map[string]ServicePrincipalSignInActivity)
+	for _, activity := range *signInActivities {
+		if activity.AppId != nil {
+			signInActivitiesByAppId[strings.ToLower(*activity.AppId)] = activity
+		}
+	}
+
+	credentialActivities, status, err := r.ReportsClient.ListAppCredentialSignInActivities(ctx, odata.Query{})
+	if err != nil {
+		return nil, status, fmt.Errorf("listing app credential sign-in activities: %v", err)
+	}
+	credentialActivitiesByAppId := make(map[string][]AppCredentialSignInActivity)
+	for _, activity := range *credentialActivities {
+		if activity.AppId != nil {
+			appId := strings.ToLower(*activity.AppId)
+			credentialActivitiesByAppId[appId] = append(credentialActivitiesByAppId[appId], activity)
+		}
+	}
+
+	servicePrincipals, status, err := r.ServicePrincipalsClient.List(ctx, odata.Query{
+		Select: []string{"id", "appId", "appOwnerOrganizationId", "appRoles", "displayName", "servicePrincipalType"},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("listing service principals: %v", err)
+	}
+
+	appRoleAssignmentsClient := &AppRoleAssignmentsClient{
+		BaseClient:   r.ServicePrincipalsClient.BaseClient,
+		resourceType: servicePrincipalsAppRoleAssignmentsResource,
+	}
+	resources := make(map[string]*resourceAccessPermissions)
+
+	for _, servicePrincipal := range *servicePrincipals {
+		if servicePrincipal.ID() == nil || servicePrincipal.AppId == nil || !isTenantOwnedServicePrincipal(servicePrincipal) {
+			continue
+		}
+		servicePrincipalId := *servicePrincipal.ID()
+
+		var activity *ServicePrincipalSignInActivity
+		if a, ok := signInActivitiesByAppId[strings.ToLower(*servicePrincipal.AppId)]; ok {
+			activity = &a
+		}
+		entry := newAppUsageReportEntry(servicePrincipal, activity, credentialActivitiesByAppId[strings.ToLower(*servicePrincipal.AppId)], report.InactiveSinceDateTime)
+
+		assignments, status, err := r.ServicePrincipalsClient.ListAppRoleAssignments(ctx, servicePrincipalId, odata.Query{})
+		if err != nil {
+			return nil, status, fmt.Errorf("listing assignees for service principal with object ID %q: %v", servicePrincipalId, err)
+		}
+		permissions := newResourceAccessPermissions(servicePrincipal)
+		for _, assignment := range *assignments {
+			if assignment.PrincipalId == nil {
+				continue
+			}
+			assignee := AppUsageAssignee{
+				PrincipalId:   *assignment.PrincipalId,
+				PrincipalType: stringValue(assignment.PrincipalType),
+				DisplayName:   stringValue(assignment.PrincipalDisplayName),
+				AppRoleId:     stringValue(assignment.AppRoleId),
+			}
+			if role, ok := permissions.byId[resourceAccessKey(ResourceAccessTypeRole, assignee.AppRoleId)]; ok {
+				assignee.AppRoleValue = role.Value
+			}
+			entry.Assignees = append(entry.Assignees, assignee)
+		}
+
+		granted, status, err := appRoleAssignmentsClient.List(ctx, servicePrincipalId, odata.Query{})
+		if err != nil {
+			return nil, status, fmt.Errorf("listing app role assignments for service principal with object ID %q: %v", servicePrincipalId, err)
+		}
+		for _, assignment := range *granted {
+			if assignment.ResourceId == nil || assignment.AppRoleId == nil {
+				continue
+			}
+			resource, status, err := r.resource(ctx, resources, *assignment.ResourceId)
+			if err != nil {
+				return nil, status, err
+			}
+			permission := AppUsagePermission{
+				ResourceId:          *assignment.ResourceId,
+				ResourceDisplayName: stringValue(assignment.ResourceDisplayName),
+				Type:                ResourceAccessTypeRole,
+				ID:                  *assignment.AppRoleId,
+			}
+			if role, ok := resource.byId[resourceAccessKey(ResourceAccessTypeRole, *assignment.AppRoleId)]; ok {
+				permission.Value = role.Value
+			}
+			entry.Permissions = append(entry.Permissions, permission)
+		}
+
+		grants, status, err := r.DelegatedPermissionGrantsClient.List(ctx, odata.Query{
+			Filter: fmt.Sprintf("clientId eq '%s'", servicePrincipalId),
+		})
+		if err != nil {
+			return nil, status, fmt.Errorf("listing delegated permission grants for service principal with object ID %q: %v", servicePrincipalId, err)
+		}
+		for _, permission := range appUsageDelegatedPermissions(*grants) {
+			resource, status, err := r.resource(ctx, resources, permission.ResourceId)
+			if err != nil {
+				return nil, status, err
+			}
+			permission.ResourceDisplayName = resource.displayName
+			entry.Permissions = append(entry.Permissions, permission)
+		}
+
+		report.Apps = append(report.Apps, entry)
+	}
+
+	sort.SliceStable(report.Apps, func(i, j int) bool {
+		if report.Apps[i].Unused != report.Apps[j].Unused {
+			return report.Apps[i].Unused
+		}
+		return strings.ToLower(report.Apps[i].DisplayName) < strings.ToLower(report.Apps[j].DisplayName)
+	})
+
+	return report, status, nil
+}
+
+// resource retrieves the resource service principal with the specified object ID, caching the result.
+func (r *AppUsageReporter) resource(ctx context.Context, resources map[string]*resourceAccessPermissions, id string) (*resourceAccessPermissions, int, error) {
+	if resource, ok := resources[id]; ok {
+		return resource, 0, nil
+	}
+
+	servicePrincipal, status, err := r.ServicePrincipalsClient.Get(ctx, id, odata.Query{
+		Select: []string{"id", "appId", "appRoles", "displayName"},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("retrieving resource service principal with object ID %q: %v", id, err)
+	}
+
+	resource := newResourceAccessPermissions(*servicePrincipal)
+	resources[id] = resource
+	return resource, status, nil
+}
+
+// newAppUsageReportEntry returns a report entry for a service principal, populated with its most recent sign-in
+// activity and that of its credentials. Sign-in activity before inactiveSince is considered stale.
+func newAppUsageReportEntry(servicePrincipal ServicePrincipal, activity *ServicePrincipalSignInActivity, credentialActivities []AppCredentialSignInActivity, inactiveSince time.Time) AppUsageReportEntry {
+	entry := AppUsageReportEntry{
+		AppId:       stringValue(servicePrincipal.AppId),
+		DisplayName: stringValue(servicePrincipal.DisplayName),
+		Assignees:   make([]AppUsageAssignee, 0),
+		Permissions: make([]AppUsagePermission, 0),
+	}
+	if servicePrincipal.ID() != nil {
+		entry.ServicePrincipalId = *servicePrincipal.ID()
+	}
+
+	if activity != nil {
+		entry.LastDelegatedSignInDateTime = latestSignInDateTime(activity.DelegatedClientSignInActivity, activity.DelegatedResourceSignInActivity)
+		entry.LastApplicationSignInDateTime = latestSignInDateTime(activity.ApplicationAuthenticationClientSignInActivity, activity.ApplicationAuthenticationResourceSignInActivity)
+		entry.LastSignInDateTime = latestSignInDateTime(activity.LastSignInActivity)
+	}
+
+	credentialSignIns := make([]*SignInActivity, 0, len(credentialActivities))
+	for _, credentialActivity := range credentialActivities {
+		credentialSignIns = append(credentialSignIns, credentialActivity.SignInActivity)
+	}
+	entry.LastCredentialSignInDateTime = latestSignInDateTime(credentialSignIns...)
+
+	for _, t := range []*time.Time{entry.LastDelegatedSignInDateTime, entry.LastApplicationSignInDateTime, entry.LastCredentialSignInDateTime} {
+		entry.LastSignInDateTime = latestDateTime(entry.LastSignInDateTime, t)
+	}
+
+	entry.Unused = entry.LastSignInDateTime == nil || entry.LastSignInDateTime.Before(inactiveSince)
+
+	return entry
+}
+
+// appUsageDelegatedPermissions returns the distinct delegated permissions granted by the provided grants. Where a scope
+// has been granted both by an administrator and by individual users, only the administrator grant is returned.
+func appUsageDelegatedPermissions(grants []DelegatedPermissionGrant) []AppUsagePermission {
+	ret := make([]AppUsagePermission, 0)
+	seen := make(map[string]int)
+	for _, grant := range grants {
+		if grant.ResourceId == nil || grant.Scopes == nil {
+			continue
+		}
+
+		var consentType DelegatedPermissionGrantConsentType
+		if grant.ConsentType != nil {
+			consentType = *grant.ConsentType
+		}
+
+		for _, scope := range *grant.Scopes {
+			if scope == "" {
+				continue
+			}
+			key := strings.ToLower(fmt.Sprintf("%s/%s", *grant.ResourceId, scope))
+			if i, ok := seen[key]; ok {
+				if consentType == DelegatedPermissionGrantConsentTypeAllPrincipals {
+					ret[i].ConsentType = consentType
+				}
+				continue
+			}
+			seen[key] = len(ret)
+			ret = append(ret, AppUsagePermission{
+				ResourceId:  *grant.ResourceId,
+				Type:        ResourceAccessTypeScope,
+				Value:       scope,
+				ConsentType: consentType,
+			})
+		}
+	}
+	return ret
+}
+
+// latestSignInDateTime returns the most recent LastSignInDateTime of the provided sign-in activities, or nil when none
+// have been recorded.
+func latestSignInDateTime(activities ...*SignInActivity) (ret *time.Time) {
+	for _, activity := range activities {
+		if activity != nil {
+			ret = latestDateTime(ret, activity.LastSignInDateTime)
+		}
+	}
+	return
+}
+
+// latestDateTime returns the later of two times, either of which may be nil.
+func latestDateTime(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,96 @@
+package msgraph
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+func TestNewAppUsageReportEntry(t *testing.T) {
+	now := time.Now()
+	inactiveSince := now.AddDate(0, 0, -90)
+	recent := now.AddDate(0, 0, -10)
+	stale := now.AddDate(0, 0, -200)
+	older := now.AddDate(0, 0, -300)
+
+	servicePrincipal := ServicePrincipal{
+		DirectoryObject: DirectoryObject{
+			Id: utils.StringPtr("11111111-1111-1111-1111-111111111111"),
+		},
+		AppId:       utils.StringPtr("22222222-2222-2222-2222-222222222222"),
+		DisplayName: utils.StringPtr("test-app"),
+	}
+
+	entry := newAppUsageReportEntry(servicePrincipal, nil, nil, inactiveSince)
+	if !entry.Unused || entry.LastSignInDateTime != nil {
+		t.Errorf("expected app without sign-in activity to be unused, got %+v", entry)
+	}
+	if entry.ServicePrincipalId != "11111111-1111-1111-1111-111111111111" || entry.AppId != "22222222-2222-2222-2222-222222222222" || entry.DisplayName != "test-app" {
+		t.Errorf("unexpected identifiers in entry: %+v", entry)
+	}
+
+	activity := &ServicePrincipalSignInActivity{
+		DelegatedClientSignInActivity:                 &SignInActivity{LastSignInDateTime: &older},
+		DelegatedResourceSignInActivity:               &SignInActivity{LastSignInDateTime: &stale},
+		ApplicationAuthenticationClientSignInActivity: &SignInActivity{},
+		LastSignInActivity:                            &SignInActivity{LastSignInDateTime: &stale},
+	}
+	entry = newAppUsageReportEntry(servicePrincipal, activity, nil, inactiveSince)
+	if !entry.Unused {
+		t.Errorf("expected app with stale sign-in activity to be unused")
+	}
+	if entry.LastDelegatedSignInDateTime == nil || !entry.LastDelegatedSignInDateTime.Equal(stale) {
+		t.Errorf("unexpected LastDelegatedSignInDateTime: %v", entry.LastDelegatedSignInDateTime)
+	}
+	if entry.LastApplicationSignInDateTime != nil {
+		t.Errorf("unexpected LastApplicationSignInDateTime: %v", entry.LastApplicationSignInDateTime)
+	}
+
+	credentialActivities := []AppCredentialSignInActivity{
+		{KeyId: utils.StringPtr("a"), SignInActivity: &SignInActivity{LastSignInDateTime: &older}},
+		{KeyId: utils.StringPtr("b"), SignInActivity: &SignInActivity{LastSignInDateTime: &recent}},
+		{KeyId: utils.StringPtr("c")},
+	}
+	entry = newAppUsageReportEntry(servicePrincipal, activity, credentialActivities, inactiveSince)
+	if entry.Unused {
+		t.Errorf("expected app with recent credential sign-in activity to be in use")
+	}
+	if entry.LastCredentialSignInDateTime == nil || !entry.LastCredentialSignInDateTime.Equal(recent) {
+		t.Errorf("unexpected LastCredentialSignInDateTime: %v", entry.LastCredentialSignInDateTime)
+	}
+	if entry.LastSignInDateTime == nil || !entry.LastSignInDateTime.Equal(recent) {
+		t.Errorf("unexpected LastSignInDateTime: %v", entry.LastSignInDateTime)
+	}
+}
+
+func TestAppUsageDelegatedPermissions(t *testing.T) {
+	resourceId := "33333333-3333-3333-3333-333333333333"
+	grants := []DelegatedPermissionGrant{
+		{
+			ConsentType: utils.StringPtr(DelegatedPermissionGrantConsentTypePrincipal),
+			ResourceId:  utils.StringPtr(resourceId),
+			Scopes:      &[]string{"User.Read", "Mail.Read"},
+		},
+		{
+			ConsentType: utils.StringPtr(DelegatedPermissionGrantConsentTypeAllPrincipals),
+			ResourceId:  utils.StringPtr(resourceId),
+			Scopes:      &[]string{"user.read", "openid"},
+		},
+		{
+			ConsentType: utils.StringPtr(DelegatedPermissionGrantConsentTypePrincipal),
+			ResourceId:  utils.StringPtr(resourceId),
+			Scopes:      &[]string{"Mail.Read", ""},
+		},
+	}
+
+	expected := []AppUsagePermission{
+		{ResourceId: resourceId, Type: ResourceAccessTypeScope, Value: "User.Read", ConsentType: DelegatedPermissionGrantConsentTypeAllPrincipals},
+		{ResourceId: resourceId, Type: ResourceAccessTypeScope, Value: "Mail.Read", ConsentType: DelegatedPermissionGrantConsentTypePrincipal},
+		{ResourceId: resourceId, Type: ResourceAccessTypeScope, Value: "openid", ConsentType: DelegatedPermissionGrantConsentTypeAllPrincipals},
+	}
+	if permissions := appUsageDelegatedPermissions(grants); !reflect.DeepEqual(permissions, expected) {
+		t.Errorf("unexpected delegated permissions\nexpected: %+v\ngot:      %+v", expected, permissions)
+	}
+}
@@ -0,0 +1,424 @@
+package msgraph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+// Reply URL types used in AAD Graph manifests
+const (
+	aadGraphReplyUrlTypeInstalledClient = "InstalledClient"
+	aadGraphReplyUrlTypeSpa             = "Spa"
+	aadGraphReplyUrlTypeWeb             = "Web"
+)
+
+// aadGraphManifestKeys are top-level properties found only in AAD Graph manifests, used to detect the manifest format.
+var aadGraphManifestKeys = []string{
+	"accessTokenAcceptedVersion", "allowPublicClient", "informationalUrls", "name", "oauth2AllowImplicitFlow",
+	"oauth2Permissions", "replyUrlsWithType", "signInUrl",
+}
+
+type aadGraphManifest struct {
+	ID                             *string                            `json:"id"`
+	AcceptMappedClaims             *bool                              `json:"acceptMappedClaims"`
+	AccessTokenAcceptedVersion     *int32                             `json:"accessTokenAcceptedVersion"`
+	AddIns                         []AddIn                            `json:"addIns"`
+	AllowPublicClient              *bool                              `json:"allowPublicClient"`
+	AppId                          *string                            `json:"appId"`
+	AppRoles                       []AppRole                          `json:"appRoles"`
+	Description                    *string                            `json:"description"`
+	GroupMembershipClaims          *string                            `json:"groupMembershipClaims"`
+	IdentifierUris                 []string                           `json:"identifierUris"`
+	InformationalUrls              *aadGraphManifestInformationalUrls `json:"informationalUrls"`
+	KnownClientApplications        []string                           `json:"knownClientApplications"`
+	Lang                           *string                            `json:"lang"`
+	LogoutUrl                      *string                            `json:"logoutUrl"`
+	Name                           *string                            `json:"name"`
+	Notes                          *string                            `json:"notes"`
+	Oauth2AllowIdTokenImplicitFlow *bool                              `json:"oauth2AllowIdTokenImplicitFlow"`
+	Oauth2AllowImplicitFlow        *bool                              `json:"oauth2AllowImplicitFlow"`
+	Oauth2AllowUrlPathMatching     *bool                              `json:"oauth2AllowUrlPathMatching"`
+	Oauth2Permissions              []PermissionScope                  `json:"oauth2Permissions"`
+	Oauth2RequirePostResponse      *bool                              `json:"oauth2RequirePostResponse"`
+	OptionalClaims                 *OptionalClaims                    `json:"optionalClaims"`
+	OrgRestrictions                []string                           `json:"orgRestrictions"`
+	ParentalControlSettings        *ParentalControlSettings           `json:"parentalControlSettings"`
+	PreAuthorizedApplications      []ApiPreAuthorizedApplication      `json:"preAuthorizedApplications"`
+	ReplyUrlsWithType              []aadGraphManifestReplyUrl         `json:"replyUrlsWithType"`
+	RequiredResourceAccess         []RequiredResourceAccess           `json:"requiredResourceAccess"`
+	SamlMetadataUrl                *string                            `json:"samlMetadataUrl"`
+	SignInUrl                      *string                            `json:"signInUrl"`
+	SignInAudience                 *SignInAudience                    `json:"signInAudience"`
+	Tags                           []string                           `json:"tags"`
+	TokenEncryptionKeyId           *string                            `json:"tokenEncryptionKeyId"`
+}
+
+type aadGraphManifestInformationalUrls struct {
+	TermsOfService *string `json:"termsOfService"`
+	Support        *string `json:"support"`
+	Privacy        *string `json:"privacy"`
+	Marketing      *string `json:"marketing"`
+}
+
+type aadGraphManifestReplyUrl struct {
+	Url  string `json:"url"`
+	Type string `json:"type"`
+}
+
+// DetectApplicationManifestFormat inspects an application manifest and returns its format. Manifests downloaded from the
+// App registrations blade in the Entra portal are either in the legacy Azure AD Graph format
+// (ApplicationManifestFormatAadGraph) or the current Microsoft Graph format (ApplicationManifestFormatMsGraph).
+func DetectApplicationManifestFormat(data []byte) (ApplicationManifestFormat, error) {
+	var manifest map[string]json.RawMessage
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return "", fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	for _, key := range aadGraphManifestKeys {
+		if _, ok := manifest[key]; ok {
+			return ApplicationManifestFormatAadGraph, nil
+		}
+	}
+
+	return ApplicationManifestFormatMsGraph, nil
+}
+
+// ExportApplicationManifest returns an application manifest for the specified Application in the specified format,
+// which can be uploaded in the Entra portal.
+func ExportApplicationManifest(application Application, format ApplicationManifestFormat) ([]byte, error) {
+	switch format {
+	case ApplicationManifestFormatAadGraph:
+		return json.MarshalIndent(newAadGraphManifest(application), "", "\t")
+	case ApplicationManifestFormatMsGraph:
+		return exportMsGraphManifest(application)
+	}
+
+	return nil, fmt.Errorf("unsupported application manifest format %q", format)
+}
+
+// ImportApplicationManifest parses an application manifest, as downloaded from the Entra portal, returning an
+// Application. When format is empty, the format of the manifest is detected automatically. Read-only properties, such
+// as appId, publisherDomain and credentials, are not imported, so the returned Application is suitable for use with
+// ApplicationsClient.Update(), or with ApplicationsClient.Create() after clearing the object ID. An error is returned
+// for AAD Graph manifests which set any of the lang, oauth2AllowUrlPathMatching or orgRestrictions properties, since
+// these have no Microsoft Graph equivalent and would otherwise be silently lost.
+func ImportApplicationManifest(data []byte, format ApplicationManifestFormat) (*Application, error) {
+	if format == "" {
+		var err error
+		if format, err = DetectApplicationManifestFormat(data); err != nil {
+			return nil, err
+		}
+	}
+
+	var application *Application
+
+	switch format {
+	case ApplicationManifestFormatAadGraph:
+		var manifest aadGraphManifest
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+		}
+		if unsupported := manifest.unsupportedProperties(); len(unsupported) > 0 {
+			return nil, fmt.Errorf("AAD Graph manifest properties have no Microsoft Graph equivalent and cannot be imported: %s", strings.Join(unsupported, ", "))
+		}
+		application = manifest.application()
+
+	case ApplicationManifestFormatMsGraph:
+		var err error
+		if application, err = importMsGraphManifest(data); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported application manifest format %q", format)
+	}
+
+	application.AppId = nil
+	application.CreatedDateTime = nil
+	application.DeletedDateTime = nil
+	application.KeyCredentials = nil
+	application.PasswordCredentials = nil
+	application.PublisherDomain = nil
+	application.VerifiedPublisher = nil
+
+	return application, nil
+}
+
+// exportMsGraphManifest returns an MS Graph format manifest. Manifests use the v1.0 schema, in which the permission IDs
+// for pre-authorized applications are named `delegatedPermissionIds`.
+func exportMsGraphManifest(application Application) ([]byte, error) {
+	data, err := json.Marshal(application)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	var manifest map[string]interface{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	if api, ok := manifest["api"].(map[string]interface{}); ok {
+		if preAuthorizedApplications, ok := api["preAuthorizedApplications"].([]interface{}); ok {
+			for _, v := range preAuthorizedApplications {
+				if preAuthorizedApplication, ok := v.(map[string]interface{}); ok {
+					preAuthorizedApplication["delegatedPermissionIds"] = preAuthorizedApplication["permissionIds"]
+					delete(preAuthorizedApplication, "permissionIds")
+				}
+			}
+		}
+	}
+
+	return json.MarshalIndent(manifest, "", "\t")
+}
+
+// importMsGraphManifest parses an MS Graph format manifest, accepting both the v1.0 and beta schemas.
+func importMsGraphManifest(data []byte) (*Application, error) {
+	var application Application
+	if err := json.Unmarshal(data, &application); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	var v1 struct {
+		Api *struct {
+			PreAuthorizedApplications []struct {
+				DelegatedPermissionIds *[]string `json:"delegatedPermissionIds"`
+			} `json:"preAuthorizedApplications"`
+		} `json:"api"`
+	}
+	if err := json.Unmarshal(data, &v1); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	if v1.Api != nil && application.Api != nil && application.Api.PreAuthorizedApplications != nil {
+		for i := range *application.Api.PreAuthorizedApplications {
+			preAuthorizedApplication := &(*application.Api.PreAuthorizedApplications)[i]
+			if preAuthorizedApplication.PermissionIds == nil && i < len(v1.Api.PreAuthorizedApplications) {
+				preAuthorizedApplication.PermissionIds = v1.Api.PreAuthorizedApplications[i].DelegatedPermissionIds
+			}
+		}
+	}
+
+	return &application, nil
+}
+
+func newAadGraphManifest(application Application) aadGraphManifest {
+	manifest := aadGraphManifest{
+		ID:                         application.ID(),
+		AddIns:                     make([]AddIn, 0),
+		AllowPublicClient:          application.IsFallbackPublicClient,
+		AppId:                      application.AppId,
+		AppRoles:                   make([]AppRole, 0),
+		IdentifierUris:             make([]string, 0),
+		KnownClientApplications:    make([]string, 0),
+		Name:                       application.DisplayName,
+		Oauth2AllowUrlPathMatching: utils.BoolPtr(false),
+		Oauth2Permissions:          make([]PermissionScope, 0),
+		Oauth2RequirePostResponse:  application.Oauth2RequirePostResponse,
+		OptionalClaims:             application.OptionalClaims,
+		OrgRestrictions:            make([]string, 0),
+		ParentalControlSettings:    application.ParentalControlSettings,
+		PreAuthorizedApplications:  make([]ApiPreAuthorizedApplication, 0),
+		ReplyUrlsWithType:          make([]aadGraphManifestReplyUrl, 0),
+		RequiredResourceAccess:     make([]RequiredResourceAccess, 0),
+		SignInAudience:             application.SignInAudience,
+		Tags:                       make([]string, 0),
+		TokenEncryptionKeyId:       application.TokenEncryptionKeyId,
+		InformationalUrls:          &aadGraphManifestInformationalUrls{},
+	}
+
+	if application.AddIns != nil {
+		manifest.AddIns = append(manifest.AddIns, *application.AddIns...)
+	}
+	if application.AppRoles != nil {
+		manifest.AppRoles = append(manifest.AppRoles, *application.AppRoles...)
+	}
+	if application.Description != nil {
+		manifest.Description = stringNullWhenEmptyPtr(application.Description)
+	}
+	if application.GroupMembershipClaims != nil && len(*application.GroupMembershipClaims) > 0 {
+		claims := strings.Join(*application.GroupMembershipClaims, ",")
+		manifest.GroupMembershipClaims = &claims
+	}
+	if application.IdentifierUris != nil {
+		manifest.IdentifierUris = append(manifest.IdentifierUris, *application.IdentifierUris...)
+	}
+	if application.Info != nil {
+		manifest.InformationalUrls = &aadGraphManifestInformationalUrls{
+			TermsOfService: stringNullWhenEmptyPtr(application.Info.TermsOfServiceUrl),
+			Support:        stringNullWhenEmptyPtr(application.Info.SupportUrl),
+			Privacy:        stringNullWhenEmptyPtr(application.Info.PrivacyStatementUrl),
+			Marketing:      stringNullWhenEmptyPtr(application.Info.MarketingUrl),
+		}
+	}
+	if application.Notes != nil {
+		manifest.Notes = stringNullWhenEmptyPtr(application.Notes)
+	}
+	if application.RequiredResourceAccess != nil {
+		manifest.RequiredResourceAccess = append(manifest.RequiredResourceAccess, *application.RequiredResourceAccess...)
+	}
+	if application.SamlMetadataUrl != nil {
+		manifest.SamlMetadataUrl = stringNullWhenEmptyPtr(application.SamlMetadataUrl)
+	}
+	if application.Tags != nil {
+		manifest.Tags = append(manifest.Tags, *application.Tags...)
+	}
+
+	if api := application.Api; api != nil {
+		manifest.AcceptMappedClaims = api.AcceptMappedClaims
+		manifest.AccessTokenAcceptedVersion = api.RequestedAccessTokenVersion
+		if api.KnownClientApplications != nil {
+			manifest.KnownClientApplications = append(manifest.KnownClientApplications, *api.KnownClientApplications...)
+		}
+		if api.OAuth2PermissionScopes != nil {
+			manifest.Oauth2Permissions = append(manifest.Oauth2Permissions, *api.OAuth2PermissionScopes...)
+		}
+		if api.PreAuthorizedApplications != nil {
+			manifest.PreAuthorizedApplications = append(manifest.PreAuthorizedApplications, *api.PreAuthorizedApplications...)
+		}
+	}
+
+	if web := application.Web; web != nil {
+		manifest.LogoutUrl = stringNullWhenEmptyPtr(web.LogoutUrl)
+		manifest.SignInUrl = stringNullWhenEmptyPtr(web.HomePageUrl)
+		if web.ImplicitGrantSettings != nil {
+			manifest.Oauth2AllowImplicitFlow = web.ImplicitGrantSettings.EnableAccessTokenIssuance
+			manifest.Oauth2AllowIdTokenImplicitFlow = web.ImplicitGrantSettings.EnableIdTokenIssuance
+		}
+		if web.RedirectUris != nil {
+			for _, u := range *web.RedirectUris {
+				manifest.ReplyUrlsWithType = append(manifest.ReplyUrlsWithType, aadGraphManifestReplyUrl{Url: u, Type: aadGraphReplyUrlTypeWeb})
+			}
+		}
+	}
+	if application.Spa != nil && application.Spa.RedirectUris != nil {
+		for _, u := range *application.Spa.RedirectUris {
+			manifest.ReplyUrlsWithType = append(manifest.ReplyUrlsWithType, aadGraphManifestReplyUrl{Url: u, Type: aadGraphReplyUrlTypeSpa})
+		}
+	}
+	if application.PublicClient != nil && application.PublicClient.RedirectUris != nil {
+		for _, u := range *application.PublicClient.RedirectUris {
+			manifest.ReplyUrlsWithType = append(manifest.ReplyUrlsWithType, aadGraphManifestReplyUrl{Url: u, Type: aadGraphReplyUrlTypeInstalledClient})
+		}
+	}
+
+	return manifest
+}
+
+func (m aadGraphManifest) application() *Application {
+	application := Application{
+		DirectoryObject: DirectoryObject{
+			Id: m.ID,
+		},
+		AppId:                     m.AppId,
+		DisplayName:               m.Name,
+		IsFallbackPublicClient:    m.AllowPublicClient,
+		Oauth2RequirePostResponse: m.Oauth2RequirePostResponse,
+		OptionalClaims:            m.OptionalClaims,
+		ParentalControlSettings:   m.ParentalControlSettings,
+		SignInAudience:            m.SignInAudience,
+		TokenEncryptionKeyId:      m.TokenEncryptionKeyId,
+		Api: &ApplicationApi{
+			AcceptMappedClaims:          m.AcceptMappedClaims,
+			KnownClientApplications:     &m.KnownClientApplications,
+			OAuth2PermissionScopes:      &m.Oauth2Permissions,
+			PreAuthorizedApplications:   &m.PreAuthorizedApplications,
+			RequestedAccessTokenVersion: m.AccessTokenAcceptedVersion,
+		},
+		AddIns:                 &m.AddIns,
+		AppRoles:               &m.AppRoles,
+		IdentifierUris:         &m.IdentifierUris,
+		RequiredResourceAccess: &m.RequiredResourceAccess,
+		Tags:                   &m.Tags,
+		PublicClient: &PublicClient{
+			RedirectUris: &[]string{},
+		},
+		Spa: &ApplicationSpa{
+			RedirectUris: &[]string{},
+		},
+		Web: &ApplicationWeb{
+			HomePageUrl: nullableStringPtr(m.SignInUrl),
+			ImplicitGrantSettings: &ImplicitGrantSettings{
+				EnableAccessTokenIssuance: m.Oauth2AllowImplicitFlow,
+				EnableIdTokenIssuance:     m.Oauth2AllowIdTokenImplicitFlow,
+			},
+			LogoutUrl:    nullableStringPtr(m.LogoutUrl),
+			RedirectUris: &[]string{},
+		},
+	}
+
+	if m.Description != nil {
+		application.Description = NullableString(StringNullWhenEmpty(*m.Description))
+	}
+	if m.Notes != nil {
+		application.Notes = NullableString(StringNullWhenEmpty(*m.Notes))
+	}
+	if m.SamlMetadataUrl != nil {
+		application.SamlMetadataUrl = NullableString(StringNullWhenEmpty(*m.SamlMetadataUrl))
+	}
+
+	if m.GroupMembershipClaims != nil {
+		claims := make([]GroupMembershipClaim, 0)
+		for _, c := range strings.Split(*m.GroupMembershipClaims, ",") {
+			if c = strings.TrimSpace(c); c != "" {
+				claims = append(claims, c)
+			}
+		}
+		application.GroupMembershipClaims = &claims
+	}
+
+	if m.InformationalUrls != nil {
+		application.Info = &InformationalUrl{
+			MarketingUrl:        nullableStringPtr(m.InformationalUrls.Marketing),
+			PrivacyStatementUrl: nullableStringPtr(m.InformationalUrls.Privacy),
+			SupportUrl:          nullableStringPtr(m.InformationalUrls.Support),
+			TermsOfServiceUrl:   nullableStringPtr(m.InformationalUrls.TermsOfService),
+		}
+	}
+
+	for _, replyUrl := range m.ReplyUrlsWithType {
+		switch replyUrl.Type {
+		case aadGraphReplyUrlTypeInstalledClient:
+			*application.PublicClient.RedirectUris = append(*application.PublicClient.RedirectUris, replyUrl.Url)
+		case aadGraphReplyUrlTypeSpa:
+			*application.Spa.RedirectUris = append(*application.Spa.RedirectUris, replyUrl.Url)
+		default:
+			*application.Web.RedirectUris = append(*application.Web.RedirectUris, replyUrl.Url)
+		}
+	}
+
+	return &application
+}
+
+// unsupportedProperties returns the names of any properties set in the manifest which cannot be represented in an
+// Application, ignoring those having their default values.
+func (m aadGraphManifest) unsupportedProperties() []string {
+	unsupported := make([]string, 0)
+	if m.Lang != nil && *m.Lang != "" {
+		unsupported = append(unsupported, "lang")
+	}
+	if m.Oauth2AllowUrlPathMatching != nil && *m.Oauth2AllowUrlPathMatching {
+		unsupported = append(unsupported, "oauth2AllowUrlPathMatching")
+	}
+	if len(m.OrgRestrictions) > 0 {
+		unsupported = append(unsupported, "orgRestrictions")
+	}
+	return unsupported
+}
+
+func nullableStringPtr(s *string) *StringNullWhenEmpty {
+	if s == nil {
+		return NullableString("")
+	}
+	return NullableString(StringNullWhenEmpty(*s))
+}
+
+func stringNullWhenEmptyPtr(s *StringNullWhenEmpty) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	v := string(*s)
+	return &v
+}
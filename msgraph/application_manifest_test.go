@@ -0,0 +1,237 @@
+package msgraph
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testAadGraphManifest = `{
+	"id": "11111111-1111-1111-1111-111111111111",
+	"acceptMappedClaims": null,
+	"accessTokenAcceptedVersion": 2,
+	"addIns": [],
+	"allowPublicClient": true,
+	"appId": "22222222-2222-2222-2222-222222222222",
+	"appRoles": [
+		{
+			"allowedMemberTypes": ["User"],
+			"description": "Administrators",
+			"displayName": "Admin",
+			"id": "33333333-3333-3333-3333-333333333333",
+			"isEnabled": true,
+			"value": "Admin"
+		}
+	],
+	"oauth2AllowIdTokenImplicitFlow": true,
+	"oauth2AllowImplicitFlow": false,
+	"oauth2AllowUrlPathMatching": false,
+	"groupMembershipClaims": "SecurityGroup,ApplicationGroup",
+	"identifierUris": ["api://test-manifest"],
+	"informationalUrls": {
+		"termsOfService": null,
+		"support": "https://support.example.com",
+		"privacy": null,
+		"marketing": null
+	},
+	"keyCredentials": [],
+	"knownClientApplications": [],
+	"lang": null,
+	"logoutUrl": "https://app.example.com/logout",
+	"name": "test-manifest",
+	"oauth2Permissions": [
+		{
+			"adminConsentDescription": "Access the API",
+			"adminConsentDisplayName": "Access the API",
+			"id": "44444444-4444-4444-4444-444444444444",
+			"isEnabled": true,
+			"type": "User",
+			"userConsentDescription": "Access the API",
+			"userConsentDisplayName": "Access the API",
+			"value": "user_impersonation"
+		}
+	],
+	"oauth2RequirePostResponse": false,
+	"optionalClaims": {
+		"idToken": [{"name": "email", "essential": false}],
+		"accessToken": [],
+		"saml2Token": []
+	},
+	"orgRestrictions": [],
+	"preAuthorizedApplications": [
+		{
+			"appId": "55555555-5555-5555-5555-555555555555",
+			"permissionIds": ["44444444-4444-4444-4444-444444444444"]
+		}
+	],
+	"publisherDomain": "example.com",
+	"replyUrlsWithType": [
+		{"url": "https://app.example.com/signin-oidc", "type": "Web"},
+		{"url": "https://app.example.com/spa", "type": "Spa"},
+		{"url": "http://localhost", "type": "InstalledClient"}
+	],
+	"requiredResourceAccess": [
+		{
+			"resourceAppId": "00000003-0000-0000-c000-000000000000",
+			"resourceAccess": [{"id": "e1fe6dd8-ba31-4d61-89e7-88639da4683d", "type": "Scope"}]
+		}
+	],
+	"samlMetadataUrl": "https://app.example.com/federationmetadata.xml",
+	"signInUrl": "https://app.example.com",
+	"signInAudience": "AzureADMyOrg",
+	"tags": ["WindowsAzureActiveDirectoryIntegratedApp"],
+	"tokenEncryptionKeyId": null
+}`
+
+func TestImportApplicationManifest_AadGraph(t *testing.T) {
+	format, err := DetectApplicationManifestFormat([]byte(testAadGraphManifest))
+	if err != nil {
+		t.Fatalf("DetectApplicationManifestFormat(): %v", err)
+	}
+	if format != ApplicationManifestFormatAadGraph {
+		t.Fatalf("expected format %q, got %q", ApplicationManifestFormatAadGraph, format)
+	}
+
+	app, err := ImportApplicationManifest([]byte(testAadGraphManifest), "")
+	if err != nil {
+		t.Fatalf("ImportApplicationManifest(): %v", err)
+	}
+
+	if app.AppId != nil || app.PublisherDomain != nil {
+		t.Errorf("expected read-only properties to be cleared on import")
+	}
+	if app.DisplayName == nil || *app.DisplayName != "test-manifest" {
+		t.Errorf("unexpected DisplayName: %v", app.DisplayName)
+	}
+	if app.IsFallbackPublicClient == nil || !*app.IsFallbackPublicClient {
+		t.Errorf("expected IsFallbackPublicClient to be true")
+	}
+	if app.Api == nil || app.Api.RequestedAccessTokenVersion == nil || *app.Api.RequestedAccessTokenVersion != 2 {
+		t.Errorf("expected Api.RequestedAccessTokenVersion to be 2")
+	}
+	if scopes := app.Api.OAuth2PermissionScopes; scopes == nil || len(*scopes) != 1 || *(*scopes)[0].Value != "user_impersonation" {
+		t.Errorf("unexpected Api.OAuth2PermissionScopes: %v", scopes)
+	}
+	if preAuth := app.Api.PreAuthorizedApplications; preAuth == nil || len(*preAuth) != 1 || len(*(*preAuth)[0].PermissionIds) != 1 {
+		t.Errorf("unexpected Api.PreAuthorizedApplications: %v", preAuth)
+	}
+	if app.AppRoles == nil || len(*app.AppRoles) != 1 {
+		t.Errorf("unexpected AppRoles: %v", app.AppRoles)
+	}
+	if app.GroupMembershipClaims == nil || !reflect.DeepEqual(*app.GroupMembershipClaims, []GroupMembershipClaim{"SecurityGroup", "ApplicationGroup"}) {
+		t.Errorf("unexpected GroupMembershipClaims: %v", app.GroupMembershipClaims)
+	}
+	if app.OptionalClaims == nil || app.OptionalClaims.IdToken == nil || len(*app.OptionalClaims.IdToken) != 1 {
+		t.Errorf("unexpected OptionalClaims: %v", app.OptionalClaims)
+	}
+	if app.RequiredResourceAccess == nil || len(*app.RequiredResourceAccess) != 1 {
+		t.Errorf("unexpected RequiredResourceAccess: %v", app.RequiredResourceAccess)
+	}
+	if !reflect.DeepEqual(*app.Web.RedirectUris, []string{"https://app.example.com/signin-oidc"}) {
+		t.Errorf("unexpected Web.RedirectUris: %v", *app.Web.RedirectUris)
+	}
+	if !reflect.DeepEqual(*app.Spa.RedirectUris, []string{"https://app.example.com/spa"}) {
+		t.Errorf("unexpected Spa.RedirectUris: %v", *app.Spa.RedirectUris)
+	}
+	if !reflect.DeepEqual(*app.PublicClient.RedirectUris, []string{"http://localhost"}) {
+		t.Errorf("unexpected PublicClient.RedirectUris: %v", *app.PublicClient.RedirectUris)
+	}
+	if app.SamlMetadataUrl == nil || *app.SamlMetadataUrl != "https://app.example.com/federationmetadata.xml" {
+		t.Errorf("unexpected SamlMetadataUrl: %v", app.SamlMetadataUrl)
+	}
+	if app.Web.ImplicitGrantSettings == nil || !*app.Web.ImplicitGrantSettings.EnableIdTokenIssuance || *app.Web.ImplicitGrantSettings.EnableAccessTokenIssuance {
+		t.Errorf("unexpected Web.ImplicitGrantSettings: %v", app.Web.ImplicitGrantSettings)
+	}
+
+	// Round trip back to the AAD Graph format
+	exported, err := ExportApplicationManifest(*app, ApplicationManifestFormatAadGraph)
+	if err != nil {
+		t.Fatalf("ExportApplicationManifest(): %v", err)
+	}
+
+	var original, roundTripped aadGraphManifest
+	if err := json.Unmarshal([]byte(testAadGraphManifest), &original); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if err := json.Unmarshal(exported, &roundTripped); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	original.AppId = nil
+	if !reflect.DeepEqual(original, roundTripped) {
+		t.Errorf("AAD Graph manifest did not round trip\nexpected: %+v\ngot:      %+v", original, roundTripped)
+	}
+}
+
+func TestImportApplicationManifest_AadGraphUnsupported(t *testing.T) {
+	var manifest map[string]interface{}
+	if err := json.Unmarshal([]byte(testAadGraphManifest), &manifest); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	manifest["lang"] = "en-US"
+	manifest["oauth2AllowUrlPathMatching"] = true
+	manifest["orgRestrictions"] = []string{"66666666-6666-6666-6666-666666666666"}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+
+	_, err = ImportApplicationManifest(data, ApplicationManifestFormatAadGraph)
+	if err == nil {
+		t.Fatal("ImportApplicationManifest(): expected error for properties with no Microsoft Graph equivalent")
+	}
+	if !strings.HasSuffix(err.Error(), ": lang, oauth2AllowUrlPathMatching, orgRestrictions") {
+		t.Errorf("ImportApplicationManifest(): error did not list unsupported properties: %v", err)
+	}
+}
+
+func TestExportApplicationManifest_MsGraph(t *testing.T) {
+	app, err := ImportApplicationManifest([]byte(testAadGraphManifest), ApplicationManifestFormatAadGraph)
+	if err != nil {
+		t.Fatalf("ImportApplicationManifest(): %v", err)
+	}
+
+	exported, err := ExportApplicationManifest(*app, ApplicationManifestFormatMsGraph)
+	if err != nil {
+		t.Fatalf("ExportApplicationManifest(): %v", err)
+	}
+
+	format, err := DetectApplicationManifestFormat(exported)
+	if err != nil {
+		t.Fatalf("DetectApplicationManifestFormat(): %v", err)
+	}
+	if format != ApplicationManifestFormatMsGraph {
+		t.Fatalf("expected format %q, got %q", ApplicationManifestFormatMsGraph, format)
+	}
+
+	var manifest struct {
+		Api struct {
+			PreAuthorizedApplications []map[string]interface{} `json:"preAuthorizedApplications"`
+		} `json:"api"`
+	}
+	if err := json.Unmarshal(exported, &manifest); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if len(manifest.Api.PreAuthorizedApplications) != 1 {
+		t.Fatalf("expected 1 pre-authorized application, got %d", len(manifest.Api.PreAuthorizedApplications))
+	}
+	if _, ok := manifest.Api.PreAuthorizedApplications[0]["delegatedPermissionIds"]; !ok {
+		t.Errorf("expected delegatedPermissionIds in exported MS Graph manifest")
+	}
+
+	imported, err := ImportApplicationManifest(exported, "")
+	if err != nil {
+		t.Fatalf("ImportApplicationManifest(): %v", err)
+	}
+	expected, err := json.Marshal(app)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+	actual, err := json.Marshal(imported)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+	if string(expected) != string(actual) {
+		t.Errorf("MS Graph manifest did not round trip\nexpected: %s\ngot:      %s", expected, actual)
+	}
+}
@@ -0,0 +1,429 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+// These application segment types are not yet provided by the odata package
+const (
+	odataTypeIpApplicationSegment    = "#microsoft.graph.ipApplicationSegment"
+	odataTypeIpSegmentConfiguration  = "#microsoft.graph.ipSegmentConfiguration"
+	odataTypeWebApplicationSegment   = "#microsoft.graph.webApplicationSegment"
+	odataTypeWebSegmentConfiguration = "#microsoft.graph.webSegmentConfiguration"
+)
+
+// NewWebSegmentConfiguration returns a SegmentConfiguration for publishing the specified web application segments. The
+// ODataType of each segment is populated automatically.
+func NewWebSegmentConfiguration(segments ...ApplicationSegment) *SegmentConfiguration {
+	for i := range segments {
+		segments[i].ODataType = utils.StringPtr(odataTypeWebApplicationSegment)
+	}
+	return &SegmentConfiguration{
+		ODataType:           utils.StringPtr(odataTypeWebSegmentConfiguration),
+		ApplicationSegments: &segments,
+	}
+}
+
+// NewIpSegmentConfiguration returns a SegmentConfiguration for publishing the specified IP application segments. The
+// ODataType of each segment is populated automatically.
+func NewIpSegmentConfiguration(segments ...ApplicationSegment) *SegmentConfiguration {
+	for i := range segments {
+		segments[i].ODataType = utils.StringPtr(odataTypeIpApplicationSegment)
+	}
+	return &SegmentConfiguration{
+		ODataType:           utils.StringPtr(odataTypeIpSegmentConfiguration),
+		ApplicationSegments: &segments,
+	}
+}
+
+// ConnectorsClient performs operations on application proxy connectors.
+type ConnectorsClient struct {
+	BaseClient Client
+}
+
+// NewConnectorsClient returns a new ConnectorsClient.
+func NewConnectorsClient() *ConnectorsClient {
+	return &ConnectorsClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// List returns a list of application proxy connectors, optionally queried using OData.
+func (c *ConnectorsClient) List(ctx context.Context, query odata.Query) (*[]Connector, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/onPremisesPublishingProfiles/applicationProxy/connectors",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectorsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Connectors []Connector `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Connectors, status, nil
+}
+
+// Get retrieves an application proxy connector.
+func (c *ConnectorsClient) Get(ctx context.Context, id string, query odata.Query) (*Connector, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/onPremisesPublishingProfiles/applicationProxy/connectors/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectorsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var connector Connector
+	if err := json.Unmarshal(respBody, &connector); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &connector, status, nil
+}
+
+// ListMemberOf returns the connector groups that an application proxy connector is a member of.
+func (c *ConnectorsClient) ListMemberOf(ctx context.Context, id string) (*[]ConnectorGroup, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/onPremisesPublishingProfiles/applicationProxy/connectors/%s/memberOf", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectorsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		ConnectorGroups []ConnectorGroup `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.ConnectorGroups, status, nil
+}
+
+// ConnectorGroupsClient performs operations on application proxy connector groups.
+type ConnectorGroupsClient struct {
+	BaseClient Client
+}
+
+// NewConnectorGroupsClient returns a new ConnectorGroupsClient.
+func NewConnectorGroupsClient() *ConnectorGroupsClient {
+	return &ConnectorGroupsClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// List returns a list of application proxy connector groups, optionally queried using OData.
+func (c *ConnectorGroupsClient) List(ctx context.Context, query odata.Query) (*[]ConnectorGroup, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/onPremisesPublishingProfiles/applicationProxy/connectorGroups",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		ConnectorGroups []ConnectorGroup `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.ConnectorGroups, status, nil
+}
+
+// Create creates a new application proxy connector group.
+func (c *ConnectorGroupsClient) Create(ctx context.Context, connectorGroup ConnectorGroup) (*ConnectorGroup, int, error) {
+	var status int
+
+	if connectorGroup.Name == nil {
+		return nil, status, errors.New("cannot create connector group with nil Name")
+	}
+
+	body, err := json.Marshal(connectorGroup)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: "/onPremisesPublishingProfiles/applicationProxy/connectorGroups",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newConnectorGroup ConnectorGroup
+	if err := json.Unmarshal(respBody, &newConnectorGroup); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newConnectorGroup, status, nil
+}
+
+// Get retrieves an application proxy connector group.
+func (c *ConnectorGroupsClient) Get(ctx context.Context, id string, query odata.Query) (*ConnectorGroup, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/onPremisesPublishingProfiles/applicationProxy/connectorGroups/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var connectorGroup ConnectorGroup
+	if err := json.Unmarshal(respBody, &connectorGroup); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &connectorGroup, status, nil
+}
+
+// Update amends an existing application proxy connector group.
+func (c *ConnectorGroupsClient) Update(ctx context.Context, connectorGroup ConnectorGroup) (int, error) {
+	var status int
+
+	if connectorGroup.ID == nil {
+		return status, errors.New("cannot update connector group with nil ID")
+	}
+
+	body, err := json.Marshal(connectorGroup)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/onPremisesPublishingProfiles/applicationProxy/connectorGroups/%s", *connectorGroup.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes an application proxy connector group. The group must not have any members or assigned applications.
+func (c *ConnectorGroupsClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/onPremisesPublishingProfiles/applicationProxy/connectorGroups/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ListMembers returns the application proxy connectors which are members of a connector group.
+func (c *ConnectorGroupsClient) ListMembers(ctx context.Context, id string) (*[]Connector, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/onPremisesPublishingProfiles/applicationProxy/connectorGroups/%s/members", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Connectors []Connector `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Connectors, status, nil
+}
+
+// AddMember moves an application proxy connector into a connector group. A connector can only be a member of a single
+// connector group, so it is removed from its existing group.
+func (c *ConnectorGroupsClient) AddMember(ctx context.Context, id, connectorId string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		ODataId string `json:"@odata.id"`
+	}{
+		ODataId: fmt.Sprintf("%s/%s/onPremisesPublishingProfiles/applicationProxy/connectorGroups/%s", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, id),
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/onPremisesPublishingProfiles/applicationProxy/connectors/%s/memberOf/$ref", connectorId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ListApplications returns the applications assigned to a connector group.
+func (c *ConnectorGroupsClient) ListApplications(ctx context.Context, id string) (*[]Application, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/onPremisesPublishingProfiles/applicationProxy/connectorGroups/%s/applications", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Applications []Application `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Applications, status, nil
+}
+
+// AddApplication assigns an application to a connector group. applicationId is the object ID of the application.
+// An application can only be assigned to a single connector group, so it is removed from its existing group.
+func (c *ConnectorGroupsClient) AddApplication(ctx context.Context, id, applicationId string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		ODataId string `json:"@odata.id"`
+	}{
+		ODataId: fmt.Sprintf("%s/%s/onPremisesPublishingProfiles/applicationProxy/connectorGroups/%s", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, id),
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Put(ctx, PutHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/applications/%s/connectorGroup/$ref", applicationId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Put(): %v", err)
+	}
+
+	return status, nil
+}
+
+// RemoveApplication removes the assignment of an application to its connector group, after which the application
+// uses the default connector group. applicationId is the object ID of the application.
+func (c *ConnectorGroupsClient) RemoveApplication(ctx context.Context, applicationId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/applications/%s/connectorGroup/$ref", applicationId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ConnectorGroupsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
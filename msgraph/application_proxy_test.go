@@ -0,0 +1,198 @@
+package msgraph_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+
+	"github.com/manicminer/hamilton/internal/test"
+	"github.com/manicminer/hamilton/internal/utils"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestConnectorGroupsClient(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	connectorGroup := testConnectorGroupsClient_Create(t, c, msgraph.ConnectorGroup{
+		Name:   utils.StringPtr(fmt.Sprintf("test-connector-group-%s", c.RandomString)),
+		Region: utils.StringPtr(msgraph.ConnectorGroupRegionEur),
+	})
+
+	connectorGroup.Name = utils.StringPtr(fmt.Sprintf("test-connector-group-updated-%s", c.RandomString))
+	testConnectorGroupsClient_Update(t, c, *connectorGroup)
+
+	testConnectorGroupsClient_List(t, c)
+	testConnectorGroupsClient_Get(t, c, *connectorGroup.ID)
+	testConnectorGroupsClient_ListMembers(t, c, *connectorGroup.ID)
+	testConnectorGroupsClient_ListApplications(t, c, *connectorGroup.ID)
+
+	connectors := testConnectorsClient_List(t, c)
+	if len(*connectors) > 0 {
+		testConnectorsClient_ListMemberOf(t, c, *(*connectors)[0].ID)
+	}
+
+	testConnectorGroupsClient_Delete(t, c, *connectorGroup.ID)
+}
+
+func testConnectorGroupsClient_Create(t *testing.T, c *test.Test, g msgraph.ConnectorGroup) (connectorGroup *msgraph.ConnectorGroup) {
+	connectorGroup, status, err := c.ConnectorGroupsClient.Create(c.Context, g)
+	if err != nil {
+		t.Fatalf("ConnectorGroupsClient.Create(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ConnectorGroupsClient.Create(): invalid status: %d", status)
+	}
+	if connectorGroup == nil {
+		t.Fatal("ConnectorGroupsClient.Create(): connectorGroup was nil")
+	}
+	if connectorGroup.ID == nil {
+		t.Fatal("ConnectorGroupsClient.Create(): connectorGroup.ID was nil")
+	}
+	return
+}
+
+func testConnectorGroupsClient_Get(t *testing.T, c *test.Test, id string) (connectorGroup *msgraph.ConnectorGroup) {
+	connectorGroup, status, err := c.ConnectorGroupsClient.Get(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("ConnectorGroupsClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ConnectorGroupsClient.Get(): invalid status: %d", status)
+	}
+	if connectorGroup == nil {
+		t.Fatal("ConnectorGroupsClient.Get(): connectorGroup was nil")
+	}
+	return
+}
+
+func testConnectorGroupsClient_Update(t *testing.T, c *test.Test, connectorGroup msgraph.ConnectorGroup) {
+	status, err := c.ConnectorGroupsClient.Update(c.Context, connectorGroup)
+	if err != nil {
+		t.Fatalf("ConnectorGroupsClient.Update(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ConnectorGroupsClient.Update(): invalid status: %d", status)
+	}
+}
+
+func testConnectorGroupsClient_List(t *testing.T, c *test.Test) (connectorGroups *[]msgraph.ConnectorGroup) {
+	connectorGroups, _, err := c.ConnectorGroupsClient.List(c.Context, odata.Query{Top: 10})
+	if err != nil {
+		t.Fatalf("ConnectorGroupsClient.List(): %v", err)
+	}
+	if connectorGroups == nil {
+		t.Fatal("ConnectorGroupsClient.List(): connectorGroups was nil")
+	}
+	return
+}
+
+func testConnectorGroupsClient_ListMembers(t *testing.T, c *test.Test, id string) (connectors *[]msgraph.Connector) {
+	connectors, _, err := c.ConnectorGroupsClient.ListMembers(c.Context, id)
+	if err != nil {
+		t.Fatalf("ConnectorGroupsClient.ListMembers(): %v", err)
+	}
+	if connectors == nil {
+		t.Fatal("ConnectorGroupsClient.ListMembers(): connectors was nil")
+	}
+	return
+}
+
+func testConnectorGroupsClient_ListApplications(t *testing.T, c *test.Test, id string) (applications *[]msgraph.Application) {
+	applications, _, err := c.ConnectorGroupsClient.ListApplications(c.Context, id)
+	if err != nil {
+		t.Fatalf("ConnectorGroupsClient.ListApplications(): %v", err)
+	}
+	if applications == nil {
+		t.Fatal("ConnectorGroupsClient.ListApplications(): applications was nil")
+	}
+	return
+}
+
+func testConnectorGroupsClient_Delete(t *testing.T, c *test.Test, id string) {
+	status, err := c.ConnectorGroupsClient.Delete(c.Context, id)
+	if err != nil {
+		t.Fatalf("ConnectorGroupsClient.Delete(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ConnectorGroupsClient.Delete(): invalid status: %d", status)
+	}
+}
+
+func testConnectorsClient_List(t *testing.T, c *test.Test) (connectors *[]msgraph.Connector) {
+	connectors, _, err := c.ConnectorsClient.List(c.Context, odata.Query{Top: 10})
+	if err != nil {
+		t.Fatalf("ConnectorsClient.List(): %v", err)
+	}
+	if connectors == nil {
+		t.Fatal("ConnectorsClient.List(): connectors was nil")
+	}
+	return
+}
+
+func testConnectorsClient_ListMemberOf(t *testing.T, c *test.Test, id string) (connectorGroups *[]msgraph.ConnectorGroup) {
+	connectorGroups, _, err := c.ConnectorsClient.ListMemberOf(c.Context, id)
+	if err != nil {
+		t.Fatalf("ConnectorsClient.ListMemberOf(): %v", err)
+	}
+	if connectorGroups == nil {
+		t.Fatal("ConnectorsClient.ListMemberOf(): connectorGroups was nil")
+	}
+	return
+}
+
+// Assigning a connector group requires an application configured for application proxy, with a connector installed
+// on premises, so AddApplication and RemoveApplication are tested against a local server.
+func TestConnectorGroupsClient_AddRemoveApplication(t *testing.T) {
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/beta/applications/11111111-1111-1111-1111-111111111111/connectorGroup/$ref" {
+			t.Errorf("unexpected request path %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decoding request body: %v", err)
+			}
+			if expected := ts.URL + "/beta/onPremisesPublishingProfiles/applicationProxy/connectorGroups/22222222-2222-2222-2222-222222222222"; body["@odata.id"] != expected {
+				t.Errorf("unexpected @odata.id %q, expected %q", body["@odata.id"], expected)
+			}
+		case http.MethodDelete:
+		default:
+			t.Errorf("unexpected method %q", r.Method)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client := msgraph.NewConnectorGroupsClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	status, err := client.AddApplication(context.Background(), "22222222-2222-2222-2222-222222222222", "11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		t.Fatalf("ConnectorGroupsClient.AddApplication(): %v", err)
+	}
+	if status != http.StatusNoContent {
+		t.Fatalf("ConnectorGroupsClient.AddApplication(): invalid status: %d", status)
+	}
+
+	status, err = client.RemoveApplication(context.Background(), "11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		t.Fatalf("ConnectorGroupsClient.RemoveApplication(): %v", err)
+	}
+	if status != http.StatusNoContent {
+		t.Fatalf("ConnectorGroupsClient.RemoveApplication(): invalid status: %d", status)
+	}
+}
@@ -24,6 +24,15 @@ func TestApplicationTemplatesClient(t *testing.T) {
 		DisplayName: utils.StringPtr(fmt.Sprintf("test-applicationTemplate-%s", c.RandomString)),
 	})
 
+	testServicePrincipalsClient_ConfigureSamlSingleSignOn(t, c, *app.ServicePrincipal.ID(), *app.Application.ID(), msgraph.SamlSingleSignOnConfiguration{
+		IdentifierUris: []string{fmt.Sprintf("https://test-applicationTemplate-%s.example.com/saml", c.RandomString)},
+		ReplyUrls:      []string{fmt.Sprintf("https://test-applicationTemplate-%s.example.com/saml/acs", c.RandomString)},
+		RelayState:     utils.StringPtr(fmt.Sprintf("https://test-applicationTemplate-%s.example.com", c.RandomString)),
+		TokenSigningCertificate: &msgraph.KeyCredential{
+			DisplayName: utils.StringPtr(fmt.Sprintf("CN=test-applicationTemplate-%s", c.RandomString)),
+		},
+	})
+
 	testServicePrincipalsClient_Delete(t, c, *app.ServicePrincipal.ID())
 
 	testApplicationsClient_Delete(t, c, *app.Application.ID())
@@ -84,3 +93,17 @@ func testApplicationTemplatesClient_Instantiate(t *testing.T, c *test.Test, a ms
 	}
 	return
 }
+
+func testServicePrincipalsClient_ConfigureSamlSingleSignOn(t *testing.T, c *test.Test, servicePrincipalId, applicationId string, config msgraph.SamlSingleSignOnConfiguration) (signingCertificate *msgraph.KeyCredential) {
+	signingCertificate, status, err := c.ServicePrincipalsClient.ConfigureSamlSingleSignOn(c.Context, servicePrincipalId, applicationId, config)
+	if err != nil {
+		t.Fatalf("ServicePrincipalsClient.ConfigureSamlSingleSignOn(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ServicePrincipalsClient.ConfigureSamlSingleSignOn(): invalid status: %d", status)
+	}
+	if config.TokenSigningCertificate != nil && signingCertificate == nil {
+		t.Fatal("ServicePrincipalsClient.ConfigureSamlSingleSignOn(): signingCertificate was nil")
+	}
+	return
+}
@@ -319,6 +319,62 @@ func (c *ApplicationsClient) SetFallbackPublicClient(ctx context.Context, id str
 	return status, nil
 }
 
+// SetOnPremisesPublishing configures an Application for publishing through application proxy, including any
+// application segments specified in SegmentsConfiguration.
+func (c *ApplicationsClient) SetOnPremisesPublishing(ctx context.Context, id string, onPremisesPublishing OnPremisesPublishing) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		OnPremisesPublishing OnPremisesPublishing `json:"onPremisesPublishing"`
+	}{
+		OnPremisesPublishing: onPremisesPublishing,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/applications/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ApplicationsClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// GetConnectorGroup retrieves the application proxy connector group to which an Application is assigned.
+func (c *ApplicationsClient) GetConnectorGroup(ctx context.Context, id string) (*ConnectorGroup, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/applications/%s/connectorGroup", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ApplicationsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var connectorGroup ConnectorGroup
+	if err := json.Unmarshal(respBody, &connectorGroup); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &connectorGroup, status, nil
+}
+
 // AddPassword appends a new password credential to an Application.
 func (c *ApplicationsClient) AddPassword(ctx context.Context, applicationId string, passwordCredential PasswordCredential) (*PasswordCredential, int, error) {
 	var status int
@@ -386,6 +442,141 @@ func (c *ApplicationsClient) RemovePassword(ctx context.Context, applicationId s
 	return status, nil
 }
 
+// AddKey appends a new certificate credential to an Application, without requiring any API permissions. proof must hold
+// an existing, valid, certificate credential for the application, which is used to sign the required proof-of-possession
+// token. Use NewKeyCredentialFromCertificate(), NewKeyCredentialFromPem() or NewKeyCredentialFromPkcs12() to prepare
+// keyCredential.
+func (c *ApplicationsClient) AddKey(ctx context.Context, applicationId string, keyCredential KeyCredential, proof ProofOfPossessionSigner) (*KeyCredential, int, error) {
+	var status int
+
+	token, err := proof.Token(applicationId)
+	if err != nil {
+		return nil, status, err
+	}
+
+	body, err := json.Marshal(struct {
+		KeyCredential      KeyCredential       `json:"keyCredential"`
+		PasswordCredential *PasswordCredential `json:"passwordCredential"`
+		Proof              string              `json:"proof"`
+	}{
+		KeyCredential: keyCredential,
+		Proof:         token,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/applications/%s/addKey", applicationId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ApplicationsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newKeyCredential KeyCredential
+	if err := json.Unmarshal(respBody, &newKeyCredential); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newKeyCredential, status, nil
+}
+
+// RemoveKey removes a certificate credential from an Application, without requiring any API permissions. proof must hold
+// a valid certificate credential for the application, which is used to sign the required proof-of-possession token.
+func (c *ApplicationsClient) RemoveKey(ctx context.Context, applicationId, keyId string, proof ProofOfPossessionSigner) (int, error) {
+	var status int
+
+	token, err := proof.Token(applicationId)
+	if err != nil {
+		return status, err
+	}
+
+	body, err := json.Marshal(struct {
+		KeyId string `json:"keyId"`
+		Proof string `json:"proof"`
+	}{
+		KeyId: keyId,
+		Proof: token,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/applications/%s/removeKey", applicationId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ApplicationsClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// BeginKeyRotation starts a zero-downtime rotation of the certificate credential held by current to the certificate
+// held by next, by adding the new certificate using a proof signed with the current certificate. Both certificates
+// remain valid until CompleteKeyRotation() is called with the returned KeyRotation, allowing consumers of the
+// certificate to be updated in the meantime. When currentKeyId is empty, the existing credential is located by its
+// thumbprint, which requires permission to read the application.
+func (c *ApplicationsClient) BeginKeyRotation(ctx context.Context, applicationId, currentKeyId string, current, next ProofOfPossessionSigner) (*KeyRotation, int, error) {
+	listKeys := func() (*[]KeyCredential, int, error) {
+		application, status, err := c.Get(ctx, applicationId, odata.Query{Select: []string{"keyCredentials"}})
+		if err != nil {
+			return nil, status, err
+		}
+		return application.KeyCredentials, status, nil
+	}
+	addKey := func(keyCredential KeyCredential, proof ProofOfPossessionSigner) (*KeyCredential, int, error) {
+		return c.AddKey(ctx, applicationId, keyCredential, proof)
+	}
+
+	return beginKeyRotation(currentKeyId, current, next, listKeys, addKey)
+}
+
+// CompleteKeyRotation completes a rotation started with BeginKeyRotation(), once the new certificate is in use, by
+// removing the previous certificate credential using a proof signed with the new certificate held by next.
+func (c *ApplicationsClient) CompleteKeyRotation(ctx context.Context, applicationId string, rotation KeyRotation, next ProofOfPossessionSigner) (int, error) {
+	removeKey := func(keyId string, proof ProofOfPossessionSigner) (int, error) {
+		return c.RemoveKey(ctx, applicationId, keyId, proof)
+	}
+
+	return completeKeyRotation(rotation, next, removeKey)
+}
+
+// RotateKey replaces the certificate credential held by current with the certificate held by next, calling
+// BeginKeyRotation() and CompleteKeyRotation() in succession. There is no period during which both certificates are
+// valid, so anything still authenticating with the current certificate fails as soon as RotateKey returns; use
+// BeginKeyRotation() and CompleteKeyRotation() directly for a zero-downtime rotation. Returns the newly added
+// KeyCredential.
+func (c *ApplicationsClient) RotateKey(ctx context.Context, applicationId, currentKeyId string, current, next ProofOfPossessionSigner) (*KeyCredential, int, error) {
+	rotation, status, err := c.BeginKeyRotation(ctx, applicationId, currentKeyId, current, next)
+	if err != nil {
+		return nil, status, err
+	}
+
+	status, err = c.CompleteKeyRotation(ctx, applicationId, *rotation, next)
+	if err != nil {
+		return rotation.NewKeyCredential, status, fmt.Errorf("new key credential was added but the existing key credential could not be removed: %v", err)
+	}
+
+	return rotation.NewKeyCredential, status, nil
+}
+
 // ListOwners retrieves the owners of the specified Application.
 // id is the object ID of the application.
 func (c *ApplicationsClient) ListOwners(ctx context.Context, id string) (*[]string, int, error) {
@@ -426,6 +617,37 @@ func (c *ApplicationsClient) ListOwners(ctx context.Context, id string) (*[]stri
 	return &ret, status, nil
 }
 
+// ListOwnerEntities retrieves the owners of the specified Application, optionally queried using OData.
+// id is the object ID of the application.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *ApplicationsClient) ListOwnerEntities(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/applications/%s/owners", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ApplicationsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
 // GetOwner retrieves a single owner for the specified Application.
 // applicationId is the object ID of the application.
 // ownerId is the object ID of the owning object.
@@ -649,6 +871,52 @@ func (c *ApplicationsClient) UploadLogo(ctx context.Context, applicationId, cont
 	return status, nil
 }
 
+// SetVerifiedPublisher sets the verified publisher of an Application, using the Microsoft Partner Network ID (MPN ID)
+// of the publisher. The publisher domain of the application must be verified, and the MPN ID must be associated with
+// the tenant.
+func (c *ApplicationsClient) SetVerifiedPublisher(ctx context.Context, applicationId, verifiedPublisherId string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		VerifiedPublisherId string `json:"verifiedPublisherId"`
+	}{
+		VerifiedPublisherId: verifiedPublisherId,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/applications/%s/setVerifiedPublisher", applicationId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ApplicationsClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// UnsetVerifiedPublisher removes the verified publisher from an Application.
+func (c *ApplicationsClient) UnsetVerifiedPublisher(ctx context.Context, applicationId string) (int, error) {
+	_, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/applications/%s/unsetVerifiedPublisher", applicationId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ApplicationsClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
 // ListFederatedIdentityCredentials returns the federated identity credentials for an application
 func (c *ApplicationsClient) ListFederatedIdentityCredentials(ctx context.Context, applicationId string, query odata.Query) (*[]FederatedIdentityCredential, int, error) {
 	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
@@ -87,6 +87,19 @@ func TestApplicationsClient(t *testing.T) {
 	testApplicationsClient_ListFederatedIdentityCredentials(t, c, *app.ID())
 	testApplicationsClient_DeleteFederatedIdentityCredential(t, c, *app.ID(), *credential.ID)
 
+	githubCredential, err := msgraph.NewGitHubActionsFederatedIdentityCredential(fmt.Sprintf("test-github-%s", c.RandomString), msgraph.GitHubActionsFederatedIdentity{
+		Organization: "manicminer-test",
+		Repository:   "gha-test",
+		EntityType:   msgraph.GitHubActionsEntityTypeBranch,
+		Value:        "main",
+	})
+	if err != nil {
+		t.Fatalf("msgraph.NewGitHubActionsFederatedIdentityCredential(): %v", err)
+	}
+	testApplicationsClient_ReconcileFederatedIdentityCredentials(t, c, *app.ID(), []msgraph.FederatedIdentityCredential{*githubCredential}, 1)
+	testApplicationsClient_ReconcileFederatedIdentityCredentials(t, c, *app.ID(), []msgraph.FederatedIdentityCredential{*githubCredential}, 0)
+	testApplicationsClient_ReconcileFederatedIdentityCredentials(t, c, *app.ID(), []msgraph.FederatedIdentityCredential{}, 0)
+
 	testApplicationsClient_List(t, c)
 	testApplicationsClient_Delete(t, c, *app.ID())
 	testApplicationsClient_ListDeleted(t, c, *app.ID())
@@ -453,3 +466,19 @@ func testApplicationsClient_DeleteFederatedIdentityCredential(t *testing.T, c *t
 		t.Fatalf("ApplicationsClient.DeleteFederatedIdentityCredential(): invalid status: %d", status)
 	}
 }
+
+func testApplicationsClient_ReconcileFederatedIdentityCredentials(t *testing.T, c *test.Test, applicationId string, desired []msgraph.FederatedIdentityCredential, expectedCreated int) {
+	result, status, err := c.ApplicationsClient.ReconcileFederatedIdentityCredentials(c.Context, applicationId, desired, true)
+	if err != nil {
+		t.Fatalf("ApplicationsClient.ReconcileFederatedIdentityCredentials(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ApplicationsClient.ReconcileFederatedIdentityCredentials(): invalid status: %d", status)
+	}
+	if result == nil {
+		t.Fatal("ApplicationsClient.ReconcileFederatedIdentityCredentials(): result was nil")
+	}
+	if len(result.Created) != expectedCreated {
+		t.Fatalf("ApplicationsClient.ReconcileFederatedIdentityCredentials(): expected %d created credentials, got %d", expectedCreated, len(result.Created))
+	}
+}
@@ -7,10 +7,20 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"path"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 )
 
+// These authentication method types are not yet provided by the odata package
+const (
+	odataTypeHardwareOathAuthenticationMethod       odata.Type = "#microsoft.graph.hardwareOathAuthenticationMethod"
+	odataTypePlatformCredentialAuthenticationMethod odata.Type = "#microsoft.graph.platformCredentialAuthenticationMethod"
+	odataTypeSoftwareOathAuthenticationMethod       odata.Type = "#microsoft.graph.softwareOathAuthenticationMethod"
+)
+
 // AuthenticationMethodsClient performs operations on the Authentications methods endpoint under Identity and Sign-in
 type AuthenticationMethodsClient struct {
 	BaseClient Client
@@ -111,6 +121,24 @@ func (c *AuthenticationMethodsClient) List(ctx context.Context, userID string, q
 				return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
 			}
 			ret = append(ret, auth)
+		case odataTypeSoftwareOathAuthenticationMethod:
+			var auth SoftwareOathAuthenticationMethod
+			if err := json.Unmarshal(authMethod, &auth); err != nil {
+				return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+			}
+			ret = append(ret, auth)
+		case odataTypeHardwareOathAuthenticationMethod:
+			var auth HardwareOathAuthenticationMethod
+			if err := json.Unmarshal(authMethod, &auth); err != nil {
+				return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+			}
+			ret = append(ret, auth)
+		case odataTypePlatformCredentialAuthenticationMethod:
+			var auth PlatformCredentialAuthenticationMethod
+			if err := json.Unmarshal(authMethod, &auth); err != nil {
+				return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+			}
+			ret = append(ret, auth)
 		}
 	}
 
@@ -767,7 +795,9 @@ func (c *AuthenticationMethodsClient) GetPasswordMethod(ctx context.Context, use
 		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
 		OData:                  query,
 		ValidStatusCodes:       []int{http.StatusOK},
-		Uri:                    Uri{},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/passwordMethods/%s", userID, id),
+		},
 	})
 	if err != nil {
 		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
@@ -786,3 +816,543 @@ func (c *AuthenticationMethodsClient) GetPasswordMethod(ctx context.Context, use
 
 	return &passwordMethod, status, nil
 }
+
+// ResetPassword resets the password for the specified password authentication method. When newPassword is nil, a
+// password is generated by the service and returned in the NewPassword field of the response. Password resets are
+// processed asynchronously, and the ID of the resulting long-running operation is returned in the OperationId field so
+// that its progress can be tracked with GetOperation or WaitForOperation.
+func (c *AuthenticationMethodsClient) ResetPassword(ctx context.Context, userID, id string, newPassword *string) (*PasswordResetResponse, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		NewPassword *string `json:"newPassword,omitempty"`
+	}{
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusAccepted},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/methods/%s/resetPassword", userID, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var passwordResetResponse PasswordResetResponse
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, &passwordResetResponse); err != nil {
+			return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+		}
+	}
+
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return nil, status, errors.New("no Location header was returned for the password reset operation")
+	}
+
+	operationUrl, err := url.Parse(location)
+	if err != nil {
+		return nil, status, fmt.Errorf("parsing Location header %q: %v", location, err)
+	}
+
+	operationId := path.Base(operationUrl.Path)
+	passwordResetResponse.OperationId = &operationId
+
+	return &passwordResetResponse, status, nil
+}
+
+// GetOperation retrieves the status of a long-running authentication operation, such as a password reset.
+func (c *AuthenticationMethodsClient) GetOperation(ctx context.Context, userID, id string) (*LongRunningOperation, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/operations/%s", userID, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var operation LongRunningOperation
+	if err := json.Unmarshal(respBody, &operation); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &operation, status, nil
+}
+
+// WaitForOperation polls a long-running authentication operation every interval until it has either succeeded or
+// failed. An error is returned if the operation fails, or if the context is cancelled or reaches its deadline first.
+func (c *AuthenticationMethodsClient) WaitForOperation(ctx context.Context, userID, id string, interval time.Duration) (*LongRunningOperation, int, error) {
+	if interval <= 0 {
+		return nil, 0, fmt.Errorf("cannot wait for operation with non-positive interval %s", interval)
+	}
+
+	for {
+		operation, status, err := c.GetOperation(ctx, userID, id)
+		if err != nil {
+			return nil, status, err
+		}
+
+		if operation.Status != nil {
+			switch *operation.Status {
+			case LongRunningOperationStatusSucceeded:
+				return operation, status, nil
+			case LongRunningOperationStatusFailed:
+				var statusDetail string
+				if operation.StatusDetail != nil {
+					statusDetail = *operation.StatusDetail
+				}
+				return operation, status, fmt.Errorf("authentication operation %q failed: %s", id, statusDetail)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return operation, status, fmt.Errorf("waiting for authentication operation %q: %v", id, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (c *AuthenticationMethodsClient) ListSoftwareOathMethods(ctx context.Context, userID string, query odata.Query) (*[]SoftwareOathAuthenticationMethod, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/softwareOathMethods", userID),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		SoftwareOathMethods []SoftwareOathAuthenticationMethod `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.SoftwareOathMethods, status, nil
+}
+
+func (c *AuthenticationMethodsClient) GetSoftwareOathMethod(ctx context.Context, userID, id string, query odata.Query) (*SoftwareOathAuthenticationMethod, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/softwareOathMethods/%s", userID, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var softwareOathMethod SoftwareOathAuthenticationMethod
+	if err := json.Unmarshal(respBody, &softwareOathMethod); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &softwareOathMethod, status, nil
+}
+
+func (c *AuthenticationMethodsClient) DeleteSoftwareOathMethod(ctx context.Context, userID, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/softwareOathMethods/%s", userID, id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+func (c *AuthenticationMethodsClient) ListPlatformCredentialMethods(ctx context.Context, userID string, query odata.Query) (*[]PlatformCredentialAuthenticationMethod, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/platformCredentialMethods", userID),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		PlatformCredentialMethods []PlatformCredentialAuthenticationMethod `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.PlatformCredentialMethods, status, nil
+}
+
+func (c *AuthenticationMethodsClient) GetPlatformCredentialMethod(ctx context.Context, userID, id string, query odata.Query) (*PlatformCredentialAuthenticationMethod, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/platformCredentialMethods/%s", userID, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var platformCredentialMethod PlatformCredentialAuthenticationMethod
+	if err := json.Unmarshal(respBody, &platformCredentialMethod); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &platformCredentialMethod, status, nil
+}
+
+func (c *AuthenticationMethodsClient) DeletePlatformCredentialMethod(ctx context.Context, userID, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/platformCredentialMethods/%s", userID, id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+func (c *AuthenticationMethodsClient) ListHardwareOathMethods(ctx context.Context, userID string, query odata.Query) (*[]HardwareOathAuthenticationMethod, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/hardwareOathMethods", userID),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		HardwareOathMethods []HardwareOathAuthenticationMethod `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.HardwareOathMethods, status, nil
+}
+
+func (c *AuthenticationMethodsClient) GetHardwareOathMethod(ctx context.Context, userID, id string, query odata.Query) (*HardwareOathAuthenticationMethod, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/hardwareOathMethods/%s", userID, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var hardwareOathMethod HardwareOathAuthenticationMethod
+	if err := json.Unmarshal(respBody, &hardwareOathMethod); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &hardwareOathMethod, status, nil
+}
+
+// AssignHardwareOathMethod assigns a hardware OATH token device to a user, creating a hardware OATH authentication method.
+// deviceID is the ID of a hardware OATH token device that has previously been added to the directory.
+func (c *AuthenticationMethodsClient) AssignHardwareOathMethod(ctx context.Context, userID, deviceID string) (*HardwareOathAuthenticationMethod, int, error) {
+	var status int
+
+	body, err := json.Marshal(HardwareOathAuthenticationMethod{
+		Device: &HardwareOathTokenAuthenticationMethodDevice{
+			ID: &deviceID,
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/hardwareOathMethods", userID),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newHardwareOathMethod HardwareOathAuthenticationMethod
+	if err := json.Unmarshal(respBody, &newHardwareOathMethod); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newHardwareOathMethod, status, nil
+}
+
+// ActivateHardwareOathMethod activates a hardware OATH token that has been assigned to a user, using a verification code
+// currently displayed on the token.
+func (c *AuthenticationMethodsClient) ActivateHardwareOathMethod(ctx context.Context, userID, id, verificationCode string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		VerificationCode string `json:"verificationCode"`
+	}{
+		VerificationCode: verificationCode,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/hardwareOathMethods/%s/activate", userID, id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+func (c *AuthenticationMethodsClient) DeleteHardwareOathMethod(ctx context.Context, userID, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/authentication/hardwareOathMethods/%s", userID, id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ListHardwareOathDevices returns the hardware OATH token devices in the directory, optionally queried using OData.
+func (c *AuthenticationMethodsClient) ListHardwareOathDevices(ctx context.Context, query odata.Query) (*[]HardwareOathTokenAuthenticationMethodDevice, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/directory/authenticationMethodDevices/hardwareOathDevices",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		HardwareOathDevices []HardwareOathTokenAuthenticationMethodDevice `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.HardwareOathDevices, status, nil
+}
+
+// GetHardwareOathDevice retrieves a hardware OATH token device.
+func (c *AuthenticationMethodsClient) GetHardwareOathDevice(ctx context.Context, id string, query odata.Query) (*HardwareOathTokenAuthenticationMethodDevice, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/directory/authenticationMethodDevices/hardwareOathDevices/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var hardwareOathDevice HardwareOathTokenAuthenticationMethodDevice
+	if err := json.Unmarshal(respBody, &hardwareOathDevice); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &hardwareOathDevice, status, nil
+}
+
+// CreateHardwareOathDevice adds a hardware OATH token device to the directory. The device can optionally be assigned
+// to a user at the same time by populating the AssignTo field.
+func (c *AuthenticationMethodsClient) CreateHardwareOathDevice(ctx context.Context, device HardwareOathTokenAuthenticationMethodDevice) (*HardwareOathTokenAuthenticationMethodDevice, int, error) {
+	var status int
+
+	body, err := json.Marshal(device)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: "/directory/authenticationMethodDevices/hardwareOathDevices",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newHardwareOathDevice HardwareOathTokenAuthenticationMethodDevice
+	if err := json.Unmarshal(respBody, &newHardwareOathDevice); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newHardwareOathDevice, status, nil
+}
+
+// UpdateHardwareOathDevice amends an existing hardware OATH token device.
+func (c *AuthenticationMethodsClient) UpdateHardwareOathDevice(ctx context.Context, device HardwareOathTokenAuthenticationMethodDevice) (int, error) {
+	var status int
+
+	if device.ID == nil {
+		return status, errors.New("cannot update hardware OATH device with nil ID")
+	}
+
+	deviceId := *device.ID
+	device.ID = nil
+
+	body, err := json.Marshal(device)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/directory/authenticationMethodDevices/hardwareOathDevices/%s", deviceId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// DeleteHardwareOathDevice removes a hardware OATH token device from the directory.
+func (c *AuthenticationMethodsClient) DeleteHardwareOathDevice(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/directory/authenticationMethodDevices/hardwareOathDevices/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("AuthenticationMethodsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
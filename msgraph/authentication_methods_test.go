@@ -1,7 +1,10 @@
 package msgraph_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -29,6 +32,10 @@ func TestAuthenticationMethodsClient(t *testing.T) {
 	_ = testAuthMethods_ListFido2Methods(t, c, *user.ID())
 	_ = testAuthMethods_ListMicrosoftAuthenticatorMethods(t, c, *user.ID())
 	_ = testAuthMethods_ListWindowsHelloMethods(t, c, *user.ID())
+	_ = testAuthMethods_ListSoftwareOathMethods(t, c, *user.ID())
+	_ = testAuthMethods_ListPlatformCredentialMethods(t, c, *user.ID())
+	_ = testAuthMethods_ListHardwareOathMethods(t, c, *user.ID())
+	_ = testAuthMethods_ListHardwareOathDevices(t, c)
 	tempAccessPass := testAuthMethods_CreateTemporaryAccessPassMethod(t, c, *user.ID())
 	_ = testAuthMethods_GetTemporaryAccessPassMethod(t, c, *user.ID(), *tempAccessPass.ID)
 	_ = testAuthMethods_ListTemporaryAccessPassMethods(t, c, *user.ID())
@@ -47,7 +54,17 @@ func TestAuthenticationMethodsClient(t *testing.T) {
 	emailAuthMethod.EmailAddress = utils.StringPtr("test-user-authenticationmethods@contoso.com")
 	testAuthMethods_UpdateEmailMethod(t, c, *user.ID(), *emailAuthMethod)
 	testAuthMethods_DeleteEmailMethod(t, c, *user.ID(), *emailAuthMethod.ID)
-	_ = testAuthMethods_ListPasswordMethods(t, c, *user.ID())
+	passwordMethods := testAuthMethods_ListPasswordMethods(t, c, *user.ID())
+	if len(*passwordMethods) > 0 {
+		_ = testAuthMethods_GetPasswordMethod(t, c, *user.ID(), *(*passwordMethods)[0].ID)
+		passwordReset := testAuthMethods_ResetPassword(t, c, *user.ID(), *(*passwordMethods)[0].ID, utils.StringPtr(fmt.Sprintf("IrPa55w0rdR3set%s", c.RandomString)))
+		_ = testAuthMethods_WaitForOperation(t, c, *user.ID(), *passwordReset.OperationId)
+		passwordReset = testAuthMethods_ResetPassword(t, c, *user.ID(), *(*passwordMethods)[0].ID, nil)
+		if passwordReset.NewPassword == nil || *passwordReset.NewPassword == "" {
+			t.Fatal("AuthenticationMethodsClientTest.ResetPassword(): NewPassword was nil for generated password")
+		}
+		_ = testAuthMethods_WaitForOperation(t, c, *user.ID(), *passwordReset.OperationId)
+	}
 	testUsersClient_Delete(t, c, *user.ID())
 }
 
@@ -368,3 +385,143 @@ func testAuthMethods_ListPasswordMethods(t *testing.T, c *test.Test, userID stri
 	}
 	return
 }
+
+func testAuthMethods_GetPasswordMethod(t *testing.T, c *test.Test, userID, id string) (passwordMethod *msgraph.PasswordAuthenticationMethod) {
+	passwordMethod, status, err := c.AuthenticationMethodsClient.GetPasswordMethod(c.Context, userID, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("AuthenticationMethodsClientTest.GetPasswordMethod(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("AuthenticationMethodsClientTest.GetPasswordMethod(): invalid status: %d", status)
+	}
+	if passwordMethod == nil {
+		t.Fatal("AuthenticationMethodsClientTest.GetPasswordMethod(): passwordMethod was nil")
+	}
+	return
+}
+
+func testAuthMethods_ResetPassword(t *testing.T, c *test.Test, userID, id string, newPassword *string) (passwordReset *msgraph.PasswordResetResponse) {
+	passwordReset, status, err := c.AuthenticationMethodsClient.ResetPassword(c.Context, userID, id, newPassword)
+	if err != nil {
+		t.Fatalf("AuthenticationMethodsClientTest.ResetPassword(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("AuthenticationMethodsClientTest.ResetPassword(): invalid status: %d", status)
+	}
+	if passwordReset == nil || passwordReset.OperationId == nil {
+		t.Fatal("AuthenticationMethodsClientTest.ResetPassword(): OperationId was nil")
+	}
+	return
+}
+
+func testAuthMethods_WaitForOperation(t *testing.T, c *test.Test, userID, id string) (operation *msgraph.LongRunningOperation) {
+	operation, status, err := c.AuthenticationMethodsClient.WaitForOperation(c.Context, userID, id, 5*time.Second)
+	if err != nil {
+		t.Fatalf("AuthenticationMethodsClientTest.WaitForOperation(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("AuthenticationMethodsClientTest.WaitForOperation(): invalid status: %d", status)
+	}
+	if operation == nil {
+		t.Fatal("AuthenticationMethodsClientTest.WaitForOperation(): operation was nil")
+	}
+	return
+}
+
+func testAuthMethods_ListSoftwareOathMethods(t *testing.T, c *test.Test, userID string) (softwareOathMethods *[]msgraph.SoftwareOathAuthenticationMethod) {
+	softwareOathMethods, status, err := c.AuthenticationMethodsClient.ListSoftwareOathMethods(c.Context, userID, odata.Query{})
+	if status < 200 || status >= 300 {
+		t.Fatalf("AuthenticationMethodsClientTest.ListSoftwareOathMethods(): invalid status: %d", status)
+	}
+
+	if err != nil {
+		t.Fatalf("AuthenticationMethodsClientTest.ListSoftwareOathMethods(): %v", err)
+	}
+
+	if softwareOathMethods == nil {
+		t.Fatal("AuthenticationMethodsClientTest.ListSoftwareOathMethods(): result was nil")
+	}
+	return
+}
+
+func testAuthMethods_ListPlatformCredentialMethods(t *testing.T, c *test.Test, userID string) (platformCredentialMethods *[]msgraph.PlatformCredentialAuthenticationMethod) {
+	platformCredentialMethods, status, err := c.AuthenticationMethodsClient.ListPlatformCredentialMethods(c.Context, userID, odata.Query{})
+	if status < 200 || status >= 300 {
+		t.Fatalf("AuthenticationMethodsClientTest.ListPlatformCredentialMethods(): invalid status: %d", status)
+	}
+
+	if err != nil {
+		t.Fatalf("AuthenticationMethodsClientTest.ListPlatformCredentialMethods(): %v", err)
+	}
+
+	if platformCredentialMethods == nil {
+		t.Fatal("AuthenticationMethodsClientTest.ListPlatformCredentialMethods(): result was nil")
+	}
+	return
+}
+
+func testAuthMethods_ListHardwareOathMethods(t *testing.T, c *test.Test, userID string) (hardwareOathMethods *[]msgraph.HardwareOathAuthenticationMethod) {
+	hardwareOathMethods, status, err := c.AuthenticationMethodsClient.ListHardwareOathMethods(c.Context, userID, odata.Query{})
+	if status < 200 || status >= 300 {
+		t.Fatalf("AuthenticationMethodsClientTest.ListHardwareOathMethods(): invalid status: %d", status)
+	}
+
+	if err != nil {
+		t.Fatalf("AuthenticationMethodsClientTest.ListHardwareOathMethods(): %v", err)
+	}
+
+	if hardwareOathMethods == nil {
+		t.Fatal("AuthenticationMethodsClientTest.ListHardwareOathMethods(): result was nil")
+	}
+	return
+}
+
+func testAuthMethods_ListHardwareOathDevices(t *testing.T, c *test.Test) (hardwareOathDevices *[]msgraph.HardwareOathTokenAuthenticationMethodDevice) {
+	hardwareOathDevices, status, err := c.AuthenticationMethodsClient.ListHardwareOathDevices(c.Context, odata.Query{})
+	if status < 200 || status >= 300 {
+		t.Fatalf("AuthenticationMethodsClientTest.ListHardwareOathDevices(): invalid status: %d", status)
+	}
+
+	if err != nil {
+		t.Fatalf("AuthenticationMethodsClientTest.ListHardwareOathDevices(): %v", err)
+	}
+
+	if hardwareOathDevices == nil {
+		t.Fatal("AuthenticationMethodsClientTest.ListHardwareOathDevices(): result was nil")
+	}
+	return
+}
+
+// The password generated by the service is only returned when no new password is specified, which is not exercised
+// against a live tenant since the generated password would then be unknown to the test.
+func TestAuthenticationMethodsClient_ResetPasswordGenerated(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/beta/users/11111111-1111-1111-1111-111111111111/authentication/methods/28c10230-6103-485e-b985-444c60001490/resetPassword" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Location", "https://graph.microsoft.com/beta/users/11111111-1111-1111-1111-111111111111/authentication/operations/33333333-3333-3333-3333-333333333333?aadgraph=true")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"@odata.context":"https://graph.microsoft.com/beta/$metadata#microsoft.graph.passwordResetResponse","newPassword":"Gen3rat3dPa55w0rd"}`))
+	}))
+	defer ts.Close()
+
+	client := msgraph.NewAuthenticationMethodsClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	passwordReset, status, err := client.ResetPassword(context.Background(), "11111111-1111-1111-1111-111111111111", "28c10230-6103-485e-b985-444c60001490", nil)
+	if err != nil {
+		t.Fatalf("AuthenticationMethodsClient.ResetPassword(): %v", err)
+	}
+	if status != http.StatusAccepted {
+		t.Fatalf("AuthenticationMethodsClient.ResetPassword(): invalid status: %d", status)
+	}
+	if passwordReset.OperationId == nil || *passwordReset.OperationId != "33333333-3333-3333-3333-333333333333" {
+		t.Errorf("AuthenticationMethodsClient.ResetPassword(): unexpected OperationId: %v", passwordReset.OperationId)
+	}
+	if passwordReset.NewPassword == nil || *passwordReset.NewPassword != "Gen3rat3dPa55w0rd" {
+		t.Errorf("AuthenticationMethodsClient.ResetPassword(): unexpected NewPassword: %v", passwordReset.NewPassword)
+	}
+}
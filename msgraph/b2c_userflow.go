@@ -164,3 +164,564 @@ func (c *B2CUserFlowClient) Delete(ctx context.Context, id string) (int, error)
 
 	return status, nil
 }
+
+// ListUserAttributeAssignments returns the user attributes collected by a B2CUserFlow, optionally queried using OData.
+func (c *B2CUserFlowClient) ListUserAttributeAssignments(ctx context.Context, id string, query odata.Query) (*[]IdentityUserFlowAttributeAssignment, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Assignments []IdentityUserFlowAttributeAssignment `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Assignments, status, nil
+}
+
+// CreateUserAttributeAssignment adds a user attribute to be collected by a B2CUserFlow. The UserAttribute field must
+// specify the ID of a built-in or custom user flow attribute.
+func (c *B2CUserFlowClient) CreateUserAttributeAssignment(ctx context.Context, id string, assignment IdentityUserFlowAttributeAssignment) (*IdentityUserFlowAttributeAssignment, int, error) {
+	var status int
+
+	if assignment.UserAttribute == nil || assignment.UserAttribute.ID == nil {
+		return nil, status, fmt.Errorf("cannot create user attribute assignment with nil UserAttribute ID")
+	}
+
+	body, err := json.Marshal(assignment)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newAssignment IdentityUserFlowAttributeAssignment
+	if err := json.Unmarshal(respBody, &newAssignment); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newAssignment, status, nil
+}
+
+// GetUserAttributeAssignment retrieves a user attribute assignment for a B2CUserFlow.
+func (c *B2CUserFlowClient) GetUserAttributeAssignment(ctx context.Context, id, assignmentId string, query odata.Query) (*IdentityUserFlowAttributeAssignment, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments/%s", id, assignmentId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var assignment IdentityUserFlowAttributeAssignment
+	if err := json.Unmarshal(respBody, &assignment); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &assignment, status, nil
+}
+
+// UpdateUserAttributeAssignment amends an existing user attribute assignment for a B2CUserFlow.
+func (c *B2CUserFlowClient) UpdateUserAttributeAssignment(ctx context.Context, id string, assignment IdentityUserFlowAttributeAssignment) (int, error) {
+	var status int
+
+	if assignment.ID == nil {
+		return status, fmt.Errorf("cannot update user attribute assignment with nil ID")
+	}
+
+	assignmentId := *assignment.ID
+	assignment.ID = nil
+
+	body, err := json.Marshal(assignment)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments/%s", id, assignmentId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// DeleteUserAttributeAssignment removes a user attribute assignment from a B2CUserFlow.
+func (c *B2CUserFlowClient) DeleteUserAttributeAssignment(ctx context.Context, id, assignmentId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments/%s", id, assignmentId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// GetUserAttributeAssignmentOrder returns the IDs of the user attribute assignments for a B2CUserFlow, in the order
+// they are displayed on the attribute collection page.
+func (c *B2CUserFlowClient) GetUserAttributeAssignmentOrder(ctx context.Context, id string) (*[]string, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments/getOrder", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Order []string `json:"order"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Order, status, nil
+}
+
+// SetUserAttributeAssignmentOrder sets the order in which user attribute assignments are displayed on the attribute
+// collection page of a B2CUserFlow. order should contain the IDs of all user attribute assignments for the user flow.
+func (c *B2CUserFlowClient) SetUserAttributeAssignmentOrder(ctx context.Context, id string, order []string) (int, error) {
+	var status int
+
+	if len(order) == 0 {
+		return status, fmt.Errorf("cannot set empty user attribute assignment order")
+	}
+
+	type assignmentOrder struct {
+		Order []string `json:"order"`
+	}
+	body, err := json.Marshal(struct {
+		NewAssignmentOrder assignmentOrder `json:"newAssignmentOrder"`
+	}{
+		NewAssignmentOrder: assignmentOrder{Order: order},
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/userAttributeAssignments/setOrder", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ListIdentityProviders returns the identity providers enabled for a B2CUserFlow.
+func (c *B2CUserFlowClient) ListIdentityProviders(ctx context.Context, id string) (*[]IdentityProvider, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		IdentityProviders []IdentityProvider `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.IdentityProviders, status, nil
+}
+
+// AddIdentityProvider enables an identity provider for a B2CUserFlow.
+func (c *B2CUserFlowClient) AddIdentityProvider(ctx context.Context, id, identityProviderId string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		ODataId string `json:"@odata.id"`
+	}{
+		ODataId: fmt.Sprintf("%s/%s/identityProviders/%s", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, identityProviderId),
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders/$ref", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// RemoveIdentityProvider disables an identity provider for a B2CUserFlow.
+func (c *B2CUserFlowClient) RemoveIdentityProvider(ctx context.Context, id, identityProviderId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/identityProviders/%s/$ref", id, identityProviderId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ListLanguages returns the language configurations for a B2CUserFlow. Language customization must be enabled for the
+// user flow, see the IsLanguageCustomizationEnabled field of B2CUserFlow.
+func (c *B2CUserFlowClient) ListLanguages(ctx context.Context, id string, query odata.Query) (*[]UserFlowLanguageConfiguration, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Languages []UserFlowLanguageConfiguration `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Languages, status, nil
+}
+
+// GetLanguage retrieves a language configuration for a B2CUserFlow. languageId is the language tag, e.g. `en`.
+func (c *B2CUserFlowClient) GetLanguage(ctx context.Context, id, languageId string, query odata.Query) (*UserFlowLanguageConfiguration, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages/%s", id, languageId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var language UserFlowLanguageConfiguration
+	if err := json.Unmarshal(respBody, &language); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &language, status, nil
+}
+
+// SetLanguage creates or replaces a custom language configuration for a B2CUserFlow. The ID field is the language
+// tag, e.g. `fr`.
+func (c *B2CUserFlowClient) SetLanguage(ctx context.Context, id string, language UserFlowLanguageConfiguration) (int, error) {
+	var status int
+
+	if language.ID == nil {
+		return status, fmt.Errorf("cannot set language with nil ID")
+	}
+
+	body, err := json.Marshal(language)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Put(ctx, PutHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusCreated, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages/%s", id, *language.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Put(): %v", err)
+	}
+
+	return status, nil
+}
+
+// DeleteLanguage removes a custom language configuration from a B2CUserFlow.
+func (c *B2CUserFlowClient) DeleteLanguage(ctx context.Context, id, languageId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages/%s", id, languageId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ListLanguageDefaultPages returns the pages having default (read-only) content for a language of a B2CUserFlow.
+func (c *B2CUserFlowClient) ListLanguageDefaultPages(ctx context.Context, id, languageId string) (*[]UserFlowLanguagePage, int, error) {
+	return c.listLanguagePages(ctx, id, languageId, "defaultPages")
+}
+
+// GetLanguageDefaultPageContent returns the default localized content of a page for a language of a B2CUserFlow.
+func (c *B2CUserFlowClient) GetLanguageDefaultPageContent(ctx context.Context, id, languageId, pageId string) ([]byte, int, error) {
+	return c.getLanguagePageContent(ctx, id, languageId, "defaultPages", pageId)
+}
+
+// ListLanguageOverridesPages returns the pages having custom content for a language of a B2CUserFlow.
+func (c *B2CUserFlowClient) ListLanguageOverridesPages(ctx context.Context, id, languageId string) (*[]UserFlowLanguagePage, int, error) {
+	return c.listLanguagePages(ctx, id, languageId, "overridesPages")
+}
+
+// GetLanguageOverridesPageContent returns the custom localized content of a page for a language of a B2CUserFlow.
+func (c *B2CUserFlowClient) GetLanguageOverridesPageContent(ctx context.Context, id, languageId, pageId string) ([]byte, int, error) {
+	return c.getLanguagePageContent(ctx, id, languageId, "overridesPages", pageId)
+}
+
+// UploadLanguageOverridesPageContent uploads custom localized content for a page for a language of a B2CUserFlow. The
+// content should be a JSON document in the same format as the default page content.
+func (c *B2CUserFlowClient) UploadLanguageOverridesPageContent(ctx context.Context, id, languageId, pageId string, content []byte) (int, error) {
+	var status int
+
+	if len(content) == 0 {
+		return status, fmt.Errorf("cannot upload empty page content")
+	}
+
+	_, status, _, err := c.BaseClient.Put(ctx, PutHttpRequestInput{
+		Body:                   content,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ContentType:            "application/json",
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages/%s/overridesPages/%s/$value", id, languageId, pageId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Put(): %v", err)
+	}
+
+	return status, nil
+}
+
+func (c *B2CUserFlowClient) listLanguagePages(ctx context.Context, id, languageId, pageType string) (*[]UserFlowLanguagePage, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages/%s/%s", id, languageId, pageType),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Pages []UserFlowLanguagePage `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Pages, status, nil
+}
+
+func (c *B2CUserFlowClient) getLanguagePageContent(ctx context.Context, id, languageId, pageType, pageId string) ([]byte, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          true,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/languages/%s/%s/%s/$value", id, languageId, pageType, pageId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	return respBody, status, nil
+}
+
+// GetApiConnectorConfiguration returns the API connectors invoked at each step of a B2CUserFlow.
+func (c *B2CUserFlowClient) GetApiConnectorConfiguration(ctx context.Context, id string) (*UserFlowApiConnectorConfiguration, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData: odata.Query{
+			Expand: odata.Expand{
+				Relationship: fmt.Sprintf("%s,%s,%s", UserFlowApiConnectorStepPostAttributeCollection, UserFlowApiConnectorStepPostFederationSignup, UserFlowApiConnectorStepPreTokenIssuance),
+			},
+		},
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/apiConnectorConfiguration", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2CUserFlowClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var configuration UserFlowApiConnectorConfiguration
+	if err := json.Unmarshal(respBody, &configuration); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &configuration, status, nil
+}
+
+// SetApiConnector configures a B2CUserFlow to invoke an API connector at the specified step.
+func (c *B2CUserFlowClient) SetApiConnector(ctx context.Context, id string, step UserFlowApiConnectorStep, apiConnectorId string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		ODataId string `json:"@odata.id"`
+	}{
+		ODataId: apiConnectorId,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Put(ctx, PutHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/apiConnectorConfiguration/%s/$ref", id, step),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Put(): %v", err)
+	}
+
+	return status, nil
+}
+
+// RemoveApiConnector configures a B2CUserFlow to no longer invoke an API connector at the specified step.
+func (c *B2CUserFlowClient) RemoveApiConnector(ctx context.Context, id string, step UserFlowApiConnectorStep) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/b2cUserFlows/%s/apiConnectorConfiguration/%s/$ref", id, step),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2CUserFlowClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
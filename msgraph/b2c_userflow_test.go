@@ -25,6 +25,14 @@ func TestB2CUserFlowClient(t *testing.T) {
 		DefaultLanguageTag: utils.StringPtr("en"),
 	})
 	testB2CUserFlowClient_List(t, c)
+
+	testB2CUserFlowClient_ListUserAttributeAssignments(t, c, *userflow.ID)
+	order := testB2CUserFlowClient_GetUserAttributeAssignmentOrder(t, c, *userflow.ID)
+	testB2CUserFlowClient_SetUserAttributeAssignmentOrder(t, c, *userflow.ID, *order)
+	testB2CUserFlowClient_ListIdentityProviders(t, c, *userflow.ID)
+	testB2CUserFlowClient_ListLanguages(t, c, *userflow.ID)
+	testB2CUserFlowClient_GetApiConnectorConfiguration(t, c, *userflow.ID)
+
 	testB2CUserFlowClient_Delete(t, c, *userflow.ID)
 }
 
@@ -89,3 +97,77 @@ func testB2CUserFlowClient_Delete(t *testing.T, c *test.Test, id string) {
 		t.Fatalf("B2CUserFlowClient.Delete(): invalid status: %d", status)
 	}
 }
+
+func testB2CUserFlowClient_ListUserAttributeAssignments(t *testing.T, c *test.Test, id string) *[]msgraph.IdentityUserFlowAttributeAssignment {
+	assignments, _, err := c.B2CUserFlowClient.ListUserAttributeAssignments(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("B2CUserFlowClient.ListUserAttributeAssignments(): %v", err)
+	}
+	if assignments == nil {
+		t.Fatal("B2CUserFlowClient.ListUserAttributeAssignments(): assignments was nil")
+	}
+	return assignments
+}
+
+func testB2CUserFlowClient_GetUserAttributeAssignmentOrder(t *testing.T, c *test.Test, id string) *[]string {
+	order, status, err := c.B2CUserFlowClient.GetUserAttributeAssignmentOrder(c.Context, id)
+	if err != nil {
+		t.Fatalf("B2CUserFlowClient.GetUserAttributeAssignmentOrder(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("B2CUserFlowClient.GetUserAttributeAssignmentOrder(): invalid status: %d", status)
+	}
+	if order == nil {
+		t.Fatal("B2CUserFlowClient.GetUserAttributeAssignmentOrder(): order was nil")
+	}
+	return order
+}
+
+func testB2CUserFlowClient_SetUserAttributeAssignmentOrder(t *testing.T, c *test.Test, id string, order []string) {
+	if len(order) == 0 {
+		return
+	}
+	status, err := c.B2CUserFlowClient.SetUserAttributeAssignmentOrder(c.Context, id, order)
+	if err != nil {
+		t.Fatalf("B2CUserFlowClient.SetUserAttributeAssignmentOrder(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("B2CUserFlowClient.SetUserAttributeAssignmentOrder(): invalid status: %d", status)
+	}
+}
+
+func testB2CUserFlowClient_ListIdentityProviders(t *testing.T, c *test.Test, id string) *[]msgraph.IdentityProvider {
+	providers, _, err := c.B2CUserFlowClient.ListIdentityProviders(c.Context, id)
+	if err != nil {
+		t.Fatalf("B2CUserFlowClient.ListIdentityProviders(): %v", err)
+	}
+	if providers == nil {
+		t.Fatal("B2CUserFlowClient.ListIdentityProviders(): providers was nil")
+	}
+	return providers
+}
+
+func testB2CUserFlowClient_ListLanguages(t *testing.T, c *test.Test, id string) *[]msgraph.UserFlowLanguageConfiguration {
+	languages, _, err := c.B2CUserFlowClient.ListLanguages(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("B2CUserFlowClient.ListLanguages(): %v", err)
+	}
+	if languages == nil {
+		t.Fatal("B2CUserFlowClient.ListLanguages(): languages was nil")
+	}
+	return languages
+}
+
+func testB2CUserFlowClient_GetApiConnectorConfiguration(t *testing.T, c *test.Test, id string) *msgraph.UserFlowApiConnectorConfiguration {
+	configuration, status, err := c.B2CUserFlowClient.GetApiConnectorConfiguration(c.Context, id)
+	if err != nil {
+		t.Fatalf("B2CUserFlowClient.GetApiConnectorConfiguration(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("B2CUserFlowClient.GetApiConnectorConfiguration(): invalid status: %d", status)
+	}
+	if configuration == nil {
+		t.Fatal("B2CUserFlowClient.GetApiConnectorConfiguration(): configuration was nil")
+	}
+	return configuration
+}
@@ -0,0 +1,286 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// CredentialExpiryReport lists the credentials of applications and/or service principals which have expired, or which
+// will expire within the report window. Use Merge() to combine reports for applications and service principals.
+type CredentialExpiryReport struct {
+	GeneratedDateTime time.Time                     `json:"generatedDateTime"`
+	WindowEndDateTime time.Time                     `json:"windowEndDateTime"`
+	Credentials       []CredentialExpiryReportEntry `json:"credentials"`
+}
+
+// CredentialExpiryReportEntry describes a single expired or expiring credential, along with the object it belongs to
+// and the object IDs of that object's owners.
+type CredentialExpiryReportEntry struct {
+	ObjectType            CredentialExpiryObjectType     `json:"objectType"`
+	ObjectId              string                         `json:"objectId"`
+	AppId                 string                         `json:"appId"`
+	DisplayName           string                         `json:"displayName"`
+	CredentialType        CredentialExpiryCredentialType `json:"credentialType"`
+	KeyId                 string                         `json:"keyId"`
+	CredentialDisplayName string                         `json:"credentialDisplayName,omitempty"`
+	Thumbprint            string                         `json:"thumbprint,omitempty"`
+	StartDateTime         *time.Time                     `json:"startDateTime,omitempty"`
+	EndDateTime           time.Time                      `json:"endDateTime"`
+	Expired               bool                           `json:"expired"`
+	DaysRemaining         int                            `json:"daysRemaining"`
+	Owners                []string                       `json:"owners"`
+}
+
+// CredentialExpiryReport pages through every Application, returning a report of password and certificate credentials
+// which have expired, or which will expire within the specified window, together with the owners of each application.
+func (c *ApplicationsClient) CredentialExpiryReport(ctx context.Context, window time.Duration) (*CredentialExpiryReport, int, error) {
+	report := newCredentialExpiryReport(window)
+
+	applications, status, err := c.List(ctx, odata.Query{
+		Select: []string{"id", "appId", "displayName", "keyCredentials", "passwordCredentials"},
+	})
+	if err != nil {
+		return nil, status, err
+	}
+
+	for _, application := range *applications {
+		if application.ID() == nil {
+			continue
+		}
+
+		entries := report.collect(CredentialExpiryObjectTypeApplication, *application.ID(), application.AppId, application.DisplayName, application.PasswordCredentials, application.KeyCredentials, "")
+		if len(entries) == 0 {
+			continue
+		}
+
+		owners, status, err := c.ListOwners(ctx, *application.ID())
+		if err != nil {
+			return nil, status, fmt.Errorf("listing owners for application with object ID %q: %v", *application.ID(), err)
+		}
+		report.add(entries, owners)
+	}
+
+	report.sort()
+
+	return report, status, nil
+}
+
+// CredentialExpiryReport pages through every Service Principal, returning a report of password, certificate and SAML
+// signing certificate credentials which have expired, or which will expire within the specified window, together with
+// the owners of each service principal.
+func (c *ServicePrincipalsClient) CredentialExpiryReport(ctx context.Context, window time.Duration) (*CredentialExpiryReport, int, error) {
+	report := newCredentialExpiryReport(window)
+
+	servicePrincipals, status, err := c.List(ctx, odata.Query{
+		Select: []string{"id", "appId", "displayName", "keyCredentials", "passwordCredentials", "preferredTokenSigningKeyThumbprint"},
+	})
+	if err != nil {
+		return nil, status, err
+	}
+
+	for _, servicePrincipal := range *servicePrincipals {
+		if servicePrincipal.ID() == nil {
+			continue
+		}
+
+		var samlThumbprint string
+		if servicePrincipal.PreferredTokenSigningKeyThumbprint != nil {
+			samlThumbprint = string(*servicePrincipal.PreferredTokenSigningKeyThumbprint)
+		}
+
+		entries := report.collect(CredentialExpiryObjectTypeServicePrincipal, *servicePrincipal.ID(), servicePrincipal.AppId, servicePrincipal.DisplayName, servicePrincipal.PasswordCredentials, servicePrincipal.KeyCredentials, samlThumbprint)
+		if len(entries) == 0 {
+			continue
+		}
+
+		owners, status, err := c.ListOwners(ctx, *servicePrincipal.ID())
+		if err != nil {
+			return nil, status, fmt.Errorf("listing owners for service principal with object ID %q: %v", *servicePrincipal.ID(), err)
+		}
+		report.add(entries, owners)
+	}
+
+	report.sort()
+
+	return report, status, nil
+}
+
+// Merge appends the credentials from another report, such as combining reports for applications and service
+// principals. The earliest generation time and window end time of the two reports are retained.
+func (r *CredentialExpiryReport) Merge(other *CredentialExpiryReport) {
+	if other == nil {
+		return
+	}
+	if other.GeneratedDateTime.Before(r.GeneratedDateTime) {
+		r.GeneratedDateTime = other.GeneratedDateTime
+	}
+	if other.WindowEndDateTime.Before(r.WindowEndDateTime) {
+		r.WindowEndDateTime = other.WindowEndDateTime
+	}
+	r.Credentials = append(r.Credentials, other.Credentials...)
+	r.sort()
+}
+
+// WriteJSON writes the report to w as indented JSON.
+func (r *CredentialExpiryReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("encoding JSON: %v", err)
+	}
+	return nil
+}
+
+// WriteCSV writes the credentials in the report to w as CSV, with a header row. Owners are separated by semicolons.
+func (r *CredentialExpiryReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{
+		"objectType", "objectId", "appId", "displayName", "credentialType", "keyId", "credentialDisplayName",
+		"thumbprint", "startDateTime", "endDateTime", "expired", "daysRemaining", "owners",
+	}); err != nil {
+		return fmt.Errorf("writing CSV: %v", err)
+	}
+
+	for _, e := range r.Credentials {
+		var startDateTime string
+		if e.StartDateTime != nil {
+			startDateTime = e.StartDateTime.Format(time.RFC3339)
+		}
+		if err := cw.Write([]string{
+			e.ObjectType, e.ObjectId, e.AppId, e.DisplayName, e.CredentialType, e.KeyId, e.CredentialDisplayName,
+			e.Thumbprint, startDateTime, e.EndDateTime.Format(time.RFC3339), strconv.FormatBool(e.Expired),
+			strconv.Itoa(e.DaysRemaining), strings.Join(e.Owners, ";"),
+		}); err != nil {
+			return fmt.Errorf("writing CSV: %v", err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("writing CSV: %v", err)
+	}
+
+	return nil
+}
+
+func newCredentialExpiryReport(window time.Duration) *CredentialExpiryReport {
+	now := time.Now()
+	return &CredentialExpiryReport{
+		GeneratedDateTime: now,
+		WindowEndDateTime: now.Add(window),
+		Credentials:       make([]CredentialExpiryReportEntry, 0),
+	}
+}
+
+// collect returns report entries for the credentials of an object which expire before the end of the report window.
+// Key credentials matching samlThumbprint are reported as SAML signing certificates. Signing certificates are
+// represented by a pair of key credentials having the same thumbprint, so these are reported once.
+func (r *CredentialExpiryReport) collect(objectType CredentialExpiryObjectType, objectId string, appId, displayName *string, passwordCredentials *[]PasswordCredential, keyCredentials *[]KeyCredential, samlThumbprint string) []CredentialExpiryReportEntry {
+	ret := make([]CredentialExpiryReportEntry, 0)
+
+	newEntry := func(credentialType CredentialExpiryCredentialType, keyId, credentialDisplayName *string, startDateTime *time.Time, endDateTime time.Time) CredentialExpiryReportEntry {
+		e := CredentialExpiryReportEntry{
+			ObjectType:     objectType,
+			ObjectId:       objectId,
+			CredentialType: credentialType,
+			StartDateTime:  startDateTime,
+			EndDateTime:    endDateTime,
+			Expired:        endDateTime.Before(r.GeneratedDateTime),
+			DaysRemaining:  int(math.Floor(endDateTime.Sub(r.GeneratedDateTime).Hours() / 24)),
+		}
+		if appId != nil {
+			e.AppId = *appId
+		}
+		if displayName != nil {
+			e.DisplayName = *displayName
+		}
+		if keyId != nil {
+			e.KeyId = *keyId
+		}
+		if credentialDisplayName != nil {
+			e.CredentialDisplayName = *credentialDisplayName
+		}
+		return e
+	}
+
+	if passwordCredentials != nil {
+		for _, credential := range *passwordCredentials {
+			if credential.EndDateTime == nil || !credential.EndDateTime.Before(r.WindowEndDateTime) {
+				continue
+			}
+			ret = append(ret, newEntry(CredentialExpiryCredentialTypePassword, credential.KeyId, credential.DisplayName, credential.StartDateTime, *credential.EndDateTime))
+		}
+	}
+
+	if keyCredentials != nil {
+		seen := make(map[string]bool)
+		for _, credential := range *keyCredentials {
+			if credential.EndDateTime == nil || !credential.EndDateTime.Before(r.WindowEndDateTime) {
+				continue
+			}
+
+			thumbprint := keyCredentialThumbprint(credential)
+			if thumbprint != "" {
+				if seen[thumbprint] {
+					continue
+				}
+				seen[thumbprint] = true
+			}
+
+			credentialType := CredentialExpiryCredentialTypeCertificate
+			if samlThumbprint != "" && strings.EqualFold(thumbprint, samlThumbprint) {
+				credentialType = CredentialExpiryCredentialTypeSamlSigningCertificate
+			}
+
+			e := newEntry(credentialType, credential.KeyId, credential.DisplayName, credential.StartDateTime, *credential.EndDateTime)
+			e.Thumbprint = thumbprint
+			ret = append(ret, e)
+		}
+	}
+
+	return ret
+}
+
+func (r *CredentialExpiryReport) add(entries []CredentialExpiryReportEntry, owners *[]string) {
+	for _, e := range entries {
+		e.Owners = make([]string, 0)
+		if owners != nil {
+			e.Owners = append(e.Owners, *owners...)
+		}
+		r.Credentials = append(r.Credentials, e)
+	}
+}
+
+// sort orders the report by credential expiry, soonest first.
+func (r *CredentialExpiryReport) sort() {
+	sort.SliceStable(r.Credentials, func(i, j int) bool {
+		return r.Credentials[i].EndDateTime.Before(r.Credentials[j].EndDateTime)
+	})
+}
+
+// keyCredentialThumbprint returns the uppercase hex encoded SHA-1 thumbprint of a certificate credential, which the API
+// returns either in the Thumbprint field or base64 encoded in the CustomKeyIdentifier field.
+func keyCredentialThumbprint(credential KeyCredential) string {
+	if credential.Thumbprint != nil && *credential.Thumbprint != "" {
+		return strings.ToUpper(*credential.Thumbprint)
+	}
+	if credential.CustomKeyIdentifier != nil {
+		if id, err := base64.StdEncoding.DecodeString(*credential.CustomKeyIdentifier); err == nil && len(id) == 20 {
+			return strings.ToUpper(hex.EncodeToString(id))
+		}
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package msgraph
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+func TestCredentialExpiryReport(t *testing.T) {
+	report := newCredentialExpiryReport(30 * 24 * time.Hour)
+
+	expired := report.GeneratedDateTime.Add(-48 * time.Hour)
+	expiring := report.GeneratedDateTime.Add(10*24*time.Hour + time.Hour)
+	valid := report.GeneratedDateTime.Add(365 * 24 * time.Hour)
+
+	passwordCredentials := []PasswordCredential{
+		{KeyId: utils.StringPtr("password-expiring"), DisplayName: utils.StringPtr("deploy"), EndDateTime: &expiring},
+		{KeyId: utils.StringPtr("password-valid"), EndDateTime: &valid},
+	}
+	keyCredentials := []KeyCredential{
+		// SAML signing certificates are represented by a Sign and Verify pair sharing the same thumbprint
+		{KeyId: utils.StringPtr("saml-sign"), CustomKeyIdentifier: utils.StringPtr("AAECAwQFBgcICQoLDA0ODxAREhM="), EndDateTime: &expired, Usage: KeyCredentialUsageSign},
+		{KeyId: utils.StringPtr("saml-verify"), CustomKeyIdentifier: utils.StringPtr("AAECAwQFBgcICQoLDA0ODxAREhM="), EndDateTime: &expired, Usage: KeyCredentialUsageVerify},
+	}
+
+	entries := report.collect(CredentialExpiryObjectTypeServicePrincipal, "11111111-1111-1111-1111-111111111111", utils.StringPtr("22222222-2222-2222-2222-222222222222"), utils.StringPtr("test-app"), &passwordCredentials, &keyCredentials, "000102030405060708090a0b0c0d0e0f10111213")
+	report.add(entries, &[]string{"33333333-3333-3333-3333-333333333333", "44444444-4444-4444-4444-444444444444"})
+	report.sort()
+
+	if len(report.Credentials) != 2 {
+		t.Fatalf("expected 2 credentials in report, got %d", len(report.Credentials))
+	}
+
+	saml := report.Credentials[0]
+	if saml.CredentialType != CredentialExpiryCredentialTypeSamlSigningCertificate {
+		t.Errorf("expected first credential to be a SAML signing certificate, got %q", saml.CredentialType)
+	}
+	if !saml.Expired || saml.DaysRemaining != -2 {
+		t.Errorf("expected SAML signing certificate to have expired 2 days ago, got Expired=%t DaysRemaining=%d", saml.Expired, saml.DaysRemaining)
+	}
+	if saml.Thumbprint != "000102030405060708090A0B0C0D0E0F10111213" {
+		t.Errorf("unexpected thumbprint for SAML signing certificate: %q", saml.Thumbprint)
+	}
+
+	password := report.Credentials[1]
+	if password.CredentialType != CredentialExpiryCredentialTypePassword || password.KeyId != "password-expiring" {
+		t.Errorf("expected second credential to be the expiring password, got %q %q", password.CredentialType, password.KeyId)
+	}
+	if password.Expired || password.DaysRemaining != 10 {
+		t.Errorf("expected password to expire in 10 days, got Expired=%t DaysRemaining=%d", password.Expired, password.DaysRemaining)
+	}
+	if len(password.Owners) != 2 {
+		t.Errorf("expected 2 owners for password, got %d", len(password.Owners))
+	}
+
+	var csvOut bytes.Buffer
+	if err := report.WriteCSV(&csvOut); err != nil {
+		t.Fatalf("CredentialExpiryReport.WriteCSV(): %v", err)
+	}
+	records, err := csv.NewReader(&csvOut).ReadAll()
+	if err != nil {
+		t.Fatalf("reading CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected 3 CSV records, got %d", len(records))
+	}
+	if owners := records[2][12]; owners != "33333333-3333-3333-3333-333333333333;44444444-4444-4444-4444-444444444444" {
+		t.Errorf("unexpected owners in CSV: %q", owners)
+	}
+
+	var jsonOut bytes.Buffer
+	if err := report.WriteJSON(&jsonOut); err != nil {
+		t.Fatalf("CredentialExpiryReport.WriteJSON(): %v", err)
+	}
+	var decoded CredentialExpiryReport
+	if err := json.Unmarshal(jsonOut.Bytes(), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if len(decoded.Credentials) != 2 {
+		t.Errorf("expected 2 credentials in decoded JSON report, got %d", len(decoded.Credentials))
+	}
+}
@@ -108,6 +108,48 @@ func (c *DirectoryObjectsClient) GetByIds(ctx context.Context, ids []string, typ
 	return &data.Objects, status, nil
 }
 
+// GetEntitiesByIds retrieves multiple directory objects from a list of IDs. Unlike GetByIds, each object is
+// unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *DirectoryObjectsClient) GetEntitiesByIds(ctx context.Context, ids []string, types []odata.ShortType) (*DirectoryObjects, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		IDs   []string          `json:"ids"`
+		Types []odata.ShortType `json:"types,omitempty"`
+	}{
+		IDs:   ids,
+		Types: types,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/directoryObjects/getByIds",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DirectoryObjectsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
 // Delete removes a DirectoryObject.
 func (c *DirectoryObjectsClient) Delete(ctx context.Context, id string) (int, error) {
 	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
@@ -217,3 +259,58 @@ func (c *DirectoryObjectsClient) GetMemberObjects(ctx context.Context, id string
 
 	return &result, status, nil
 }
+
+// GetMemberGroupEntities retrieves the groups and directory roles that a directory object is a member of. Unlike
+// GetMemberGroups, which returns only object IDs, each object is retrieved and unmarshaled into a concrete model
+// according to its type, see DirectoryObjects.
+func (c *DirectoryObjectsClient) GetMemberGroupEntities(ctx context.Context, id string, securityEnabledOnly bool) (*DirectoryObjects, int, error) {
+	memberGroups, status, err := c.GetMemberGroups(ctx, id, securityEnabledOnly)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return c.getEntitiesForObjects(ctx, *memberGroups, status)
+}
+
+// GetMemberObjectEntities retrieves the groups, directory roles and administrative units that a directory object is a
+// member of. Unlike GetMemberObjects, which returns only object IDs, each object is retrieved and unmarshaled into a
+// concrete model according to its type, see DirectoryObjects.
+func (c *DirectoryObjectsClient) GetMemberObjectEntities(ctx context.Context, id string, securityEnabledOnly bool) (*DirectoryObjects, int, error) {
+	memberObjects, status, err := c.GetMemberObjects(ctx, id, securityEnabledOnly)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return c.getEntitiesForObjects(ctx, *memberObjects, status)
+}
+
+// getEntitiesForObjects retrieves the concrete models for the provided directory objects, in batches no larger than
+// the maximum number of IDs accepted by getByIds.
+func (c *DirectoryObjectsClient) getEntitiesForObjects(ctx context.Context, objects []DirectoryObject, status int) (*DirectoryObjects, int, error) {
+	const maxIdsPerRequest = 1000
+
+	ids := make([]string, 0, len(objects))
+	for _, object := range objects {
+		if object.Id != nil {
+			ids = append(ids, *object.Id)
+		}
+	}
+
+	ret := make(DirectoryObjects, 0, len(ids))
+	for len(ids) > 0 {
+		batch := ids
+		if len(batch) > maxIdsPerRequest {
+			batch = ids[:maxIdsPerRequest]
+		}
+		ids = ids[len(batch):]
+
+		entities, batchStatus, err := c.GetEntitiesByIds(ctx, batch, nil)
+		status = batchStatus
+		if err != nil {
+			return nil, status, err
+		}
+		ret = append(ret, *entities...)
+	}
+
+	return &ret, status, nil
+}
@@ -49,7 +49,10 @@ func TestDirectoryObjectsClient(t *testing.T) {
 	testDirectoryObjectsClient_Get(t, c, *group1.ID())
 	testDirectoryObjectsClient_GetMemberGroups(t, c, *user.ID(), true, []string{*group1.ID(), *group2.ID()})
 	testDirectoryObjectsClient_GetMemberObjects(t, c, *group1.ID(), true, []string{*group2.ID()})
+	testDirectoryObjectsClient_GetMemberGroupEntities(t, c, *user.ID(), true, 2)
+	testDirectoryObjectsClient_GetMemberObjectEntities(t, c, *group1.ID(), true, 1)
 	testDirectoryObjectsClient_GetByIds(t, c, []string{*group1.ID(), *group2.ID(), *user.ID()}, []string{odata.ShortTypeGroup})
+	testDirectoryObjectsClient_GetEntitiesByIds(t, c, []string{*group1.ID(), *group2.ID(), *user.ID()}, nil, 1, 2)
 	testDirectoryObjectsClient_Delete(t, c, *group1.ID())
 }
 
@@ -87,6 +90,26 @@ func testDirectoryObjectsClient_GetByIds(t *testing.T, c *test.Test, ids []strin
 	return
 }
 
+func testDirectoryObjectsClient_GetEntitiesByIds(t *testing.T, c *test.Test, ids []string, types []odata.ShortType, expectedUsers, expectedGroups int) (directoryObjects *msgraph.DirectoryObjects) {
+	directoryObjects, status, err := c.DirectoryObjectsClient.GetEntitiesByIds(c.Context, ids, types)
+	if err != nil {
+		t.Fatalf("DirectoryObjectsClient.GetEntitiesByIds(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("DirectoryObjectsClient.GetEntitiesByIds(): invalid status: %d", status)
+	}
+	if directoryObjects == nil {
+		t.Fatal("DirectoryObjectsClient.GetEntitiesByIds(): directoryObjects was nil")
+	}
+	if users := directoryObjects.Users(); len(users) != expectedUsers {
+		t.Fatalf("DirectoryObjectsClient.GetEntitiesByIds(): expected %d users, got %d", expectedUsers, len(users))
+	}
+	if groups := directoryObjects.Groups(); len(groups) != expectedGroups {
+		t.Fatalf("DirectoryObjectsClient.GetEntitiesByIds(): expected %d groups, got %d", expectedGroups, len(groups))
+	}
+	return
+}
+
 func testDirectoryObjectsClient_GetMemberGroups(t *testing.T, c *test.Test, id string, securityEnabledOnly bool, expected []string) (directoryObjects *[]msgraph.DirectoryObject) {
 	directoryObjects, status, err := c.DirectoryObjectsClient.GetMemberGroups(c.Context, id, securityEnabledOnly)
 	if err != nil {
@@ -162,3 +185,37 @@ func testDirectoryObjectsClient_Delete(t *testing.T, c *test.Test, id string) {
 		t.Fatalf("DirectoryObjectsClient.Delete(): invalid status: %d", status)
 	}
 }
+
+func testDirectoryObjectsClient_GetMemberGroupEntities(t *testing.T, c *test.Test, id string, securityEnabledOnly bool, expectedGroups int) (directoryObjects *msgraph.DirectoryObjects) {
+	directoryObjects, status, err := c.DirectoryObjectsClient.GetMemberGroupEntities(c.Context, id, securityEnabledOnly)
+	if err != nil {
+		t.Fatalf("DirectoryObjectsClient.GetMemberGroupEntities(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("DirectoryObjectsClient.GetMemberGroupEntities(): invalid status: %d", status)
+	}
+	if directoryObjects == nil {
+		t.Fatal("DirectoryObjectsClient.GetMemberGroupEntities(): directoryObjects was nil")
+	}
+	if groups := directoryObjects.Groups(); len(groups) < expectedGroups {
+		t.Fatalf("DirectoryObjectsClient.GetMemberGroupEntities(): expected at least %d groups, got %d", expectedGroups, len(groups))
+	}
+	return
+}
+
+func testDirectoryObjectsClient_GetMemberObjectEntities(t *testing.T, c *test.Test, id string, securityEnabledOnly bool, expectedGroups int) (directoryObjects *msgraph.DirectoryObjects) {
+	directoryObjects, status, err := c.DirectoryObjectsClient.GetMemberObjectEntities(c.Context, id, securityEnabledOnly)
+	if err != nil {
+		t.Fatalf("DirectoryObjectsClient.GetMemberObjectEntities(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("DirectoryObjectsClient.GetMemberObjectEntities(): invalid status: %d", status)
+	}
+	if directoryObjects == nil {
+		t.Fatal("DirectoryObjectsClient.GetMemberObjectEntities(): directoryObjects was nil")
+	}
+	if groups := directoryObjects.Groups(); len(groups) < expectedGroups {
+		t.Fatalf("DirectoryObjectsClient.GetMemberObjectEntities(): expected at least %d groups, got %d", expectedGroups, len(groups))
+	}
+	return
+}
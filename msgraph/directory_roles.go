@@ -143,6 +143,37 @@ func (c *DirectoryRolesClient) ListMembers(ctx context.Context, id string) (*[]s
 	return &ret, status, nil
 }
 
+// ListMemberEntities retrieves the members of the specified directory role, optionally queried using OData.
+// id is the object ID of the directory role.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *DirectoryRolesClient) ListMemberEntities(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/directoryRoles/%s/members", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("DirectoryRolesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
 // AddMembers adds new members to a Directory Role.
 // First populate the `members` field, then call this method
 func (c *DirectoryRolesClient) AddMembers(ctx context.Context, directoryRole *DirectoryRole) (int, error) {
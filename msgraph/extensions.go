@@ -0,0 +1,188 @@
+package msgraph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// odataTypeOpenTypeExtension is not yet provided by the odata package
+const odataTypeOpenTypeExtension odata.Type = "#microsoft.graph.openTypeExtension"
+
+// SchemaExtensionValue holds a typed value for a schema extension or a directory extension. It implements
+// SchemaExtensionProperties, so it can be used in the SchemaExtensions field of a User or Group to be included in create
+// and update payloads, and to be populated when retrieving an object using GetWithExtensions().
+//
+// For a schema extension, T should be a struct describing the extension properties, with JSON tags matching the
+// property names. For a directory extension, T should be the type of the extension property, e.g. string or []string.
+// A nil Value is marshaled as null, which removes the extension value from an object when updating it.
+type SchemaExtensionValue[T any] struct {
+	Value *T
+}
+
+func (v SchemaExtensionValue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Value)
+}
+
+func (v *SchemaExtensionValue[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		v.Value = nil
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	v.Value = &value
+	return nil
+}
+
+// NewSchemaExtensionData returns a SchemaExtensionData holding a typed value for the schema extension or directory
+// extension with the specified ID. To retrieve an extension value, pass a nil value and then use
+// GetSchemaExtensionValue() with the populated SchemaExtensions.
+func NewSchemaExtensionData[T any](id string, value *T) SchemaExtensionData {
+	return SchemaExtensionData{
+		ID:         id,
+		Properties: &SchemaExtensionValue[T]{Value: value},
+	}
+}
+
+// GetSchemaExtensionValue returns the typed value of the schema extension or directory extension with the specified ID.
+// Returns nil when the extension is not present or has no value, and an error when the extension is present but was
+// not created using NewSchemaExtensionData() with the same type.
+func GetSchemaExtensionValue[T any](schemaExtensions *[]SchemaExtensionData, id string) (*T, error) {
+	if schemaExtensions == nil {
+		return nil, nil
+	}
+	for _, ext := range *schemaExtensions {
+		if ext.ID != id {
+			continue
+		}
+		if ext.Properties == nil {
+			return nil, nil
+		}
+		v, ok := ext.Properties.(*SchemaExtensionValue[T])
+		if !ok {
+			return nil, fmt.Errorf("schema extension %q has properties of type %T", id, ext.Properties)
+		}
+		return v.Value, nil
+	}
+	return nil, nil
+}
+
+// DirectoryExtensionName returns the property name of a directory extension, as created by ApplicationsClient.CreateExtension().
+// appId is the application (client) ID of the application owning the extension, and name is the short extension name.
+func DirectoryExtensionName(appId, name string) string {
+	return fmt.Sprintf("extension_%s_%s", strings.ReplaceAll(appId, "-", ""), name)
+}
+
+// selectSchemaExtensions merges the IDs of the specified extensions into the $select of a query, along with the object
+// ID. Extension values are only returned by the API when explicitly selected, so when the query does not select any
+// properties, the extensions are selected along with defaultProperties.
+func selectSchemaExtensions(query odata.Query, schemaExtensions *[]SchemaExtensionData, defaultProperties []string) odata.Query {
+	if schemaExtensions == nil || len(*schemaExtensions) == 0 {
+		return query
+	}
+
+	base := query.Select
+	if len(base) == 0 {
+		base = defaultProperties
+	}
+
+	sel := make([]string, 0, len(base)+len(*schemaExtensions)+1)
+	seen := make(map[string]bool)
+	for _, s := range append(append([]string{"id"}, base...), schemaExtensionIds(schemaExtensions)...) {
+		if !seen[s] {
+			seen[s] = true
+			sel = append(sel, s)
+		}
+	}
+	query.Select = sel
+
+	return query
+}
+
+func schemaExtensionIds(schemaExtensions *[]SchemaExtensionData) []string {
+	ret := make([]string, 0, len(*schemaExtensions))
+	for _, ext := range *schemaExtensions {
+		ret = append(ret, ext.ID)
+	}
+	return ret
+}
+
+// OpenExtension is an open extension (openTypeExtension) holding typed custom properties. T should be a struct
+// describing the custom properties with corresponding JSON tags, or a map[string]interface{} for untyped properties.
+// The custom properties are flattened alongside ExtensionName when marshaled, as expected by the API.
+type OpenExtension[T any] struct {
+	ExtensionName *string
+	ID            *string
+	Value         *T
+}
+
+func (e OpenExtension[T]) MarshalJSON() ([]byte, error) {
+	docs := make([][]byte, 0)
+
+	d, err := json.Marshal(struct {
+		ODataType     odata.Type `json:"@odata.type"`
+		ExtensionName *string    `json:"extensionName,omitempty"`
+	}{
+		ODataType:     odataTypeOpenTypeExtension,
+		ExtensionName: e.ExtensionName,
+	})
+	if err != nil {
+		return d, err
+	}
+	docs = append(docs, d)
+
+	if e.Value != nil {
+		d, err := json.Marshal(e.Value)
+		if err != nil {
+			return d, err
+		}
+		docs = append(docs, d)
+	}
+
+	return MarshalDocs(docs)
+}
+
+func (e *OpenExtension[T]) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	e.ExtensionName = nil
+	e.ID = nil
+	if v, ok := fields["extensionName"]; ok {
+		if err := json.Unmarshal(v, &e.ExtensionName); err != nil {
+			return err
+		}
+	}
+	if v, ok := fields["id"]; ok {
+		if err := json.Unmarshal(v, &e.ID); err != nil {
+			return err
+		}
+	}
+
+	// Remove the well-known properties so that only the custom properties are decoded into the value
+	for k := range fields {
+		if k == "extensionName" || k == "id" || strings.HasPrefix(k, "@odata.") {
+			delete(fields, k)
+		}
+	}
+	properties, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	var value T
+	if err := json.Unmarshal(properties, &value); err != nil {
+		return err
+	}
+	e.Value = &value
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package msgraph_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+type testCostCenter struct {
+	CostCenter string `json:"costCenter"`
+	Division   string `json:"division,omitempty"`
+}
+
+func TestSchemaExtensionValue(t *testing.T) {
+	const directoryExtension = "extension_b7d8e5fbe9cc4f4a9d4e6d3e1c8f8a12_employeeBand"
+	if name := msgraph.DirectoryExtensionName("b7d8e5fb-e9cc-4f4a-9d4e-6d3e1c8f8a12", "employeeBand"); name != directoryExtension {
+		t.Fatalf("DirectoryExtensionName(): unexpected name %q", name)
+	}
+
+	user := msgraph.User{
+		DisplayName: utils.StringPtr("test-user"),
+		SchemaExtensions: &[]msgraph.SchemaExtensionData{
+			msgraph.NewSchemaExtensionData("contoso_costCenter", &testCostCenter{CostCenter: "1234"}),
+			msgraph.NewSchemaExtensionData(directoryExtension, utils.StringPtr("B4")),
+			msgraph.NewSchemaExtensionData[int]("extension_b7d8e5fbe9cc4f4a9d4e6d3e1c8f8a12_removed", nil),
+		},
+	}
+	body, err := json.Marshal(user)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if v, ok := fields[directoryExtension]; !ok || v != "B4" {
+		t.Fatalf("expected directory extension value to be marshaled, got %v", v)
+	}
+	if v, ok := fields["extension_b7d8e5fbe9cc4f4a9d4e6d3e1c8f8a12_removed"]; !ok || v != nil {
+		t.Fatalf("expected nil extension value to be marshaled as null, got %v", v)
+	}
+	if v, ok := fields["contoso_costCenter"].(map[string]interface{}); !ok || v["costCenter"] != "1234" {
+		t.Fatalf("expected schema extension value to be marshaled, got %v", fields["contoso_costCenter"])
+	}
+
+	decoded := msgraph.User{
+		SchemaExtensions: &[]msgraph.SchemaExtensionData{
+			msgraph.NewSchemaExtensionData[testCostCenter]("contoso_costCenter", nil),
+			msgraph.NewSchemaExtensionData[string](directoryExtension, nil),
+		},
+	}
+	if err := json.Unmarshal([]byte(`{"id": "11111111-1111-1111-1111-111111111111", "contoso_costCenter": {"costCenter": "5678", "division": "R&D"}, "`+directoryExtension+`": "B5"}`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+
+	costCenter, err := msgraph.GetSchemaExtensionValue[testCostCenter](decoded.SchemaExtensions, "contoso_costCenter")
+	if err != nil {
+		t.Fatalf("GetSchemaExtensionValue(): %v", err)
+	}
+	if costCenter == nil || costCenter.CostCenter != "5678" || costCenter.Division != "R&D" {
+		t.Fatalf("unexpected schema extension value: %+v", costCenter)
+	}
+
+	band, err := msgraph.GetSchemaExtensionValue[string](decoded.SchemaExtensions, directoryExtension)
+	if err != nil {
+		t.Fatalf("GetSchemaExtensionValue(): %v", err)
+	}
+	if band == nil || *band != "B5" {
+		t.Fatalf("unexpected directory extension value: %v", band)
+	}
+
+	if _, err := msgraph.GetSchemaExtensionValue[int](decoded.SchemaExtensions, directoryExtension); err == nil {
+		t.Fatal("GetSchemaExtensionValue(): expected error for mismatched type")
+	}
+}
+
+func TestOpenExtension(t *testing.T) {
+	ext := msgraph.OpenExtension[testCostCenter]{
+		ExtensionName: utils.StringPtr("com.contoso.costCenter"),
+		Value:         &testCostCenter{CostCenter: "1234"},
+	}
+	body, err := json.Marshal(ext)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if fields["@odata.type"] != "#microsoft.graph.openTypeExtension" || fields["extensionName"] != "com.contoso.costCenter" || fields["costCenter"] != "1234" {
+		t.Fatalf("unexpected marshaled open extension: %s", body)
+	}
+
+	var decoded msgraph.OpenExtension[map[string]interface{}]
+	if err := json.Unmarshal([]byte(`{"@odata.type": "#microsoft.graph.openTypeExtension", "extensionName": "com.contoso.costCenter", "id": "com.contoso.costCenter", "costCenter": "1234", "division": "R&D"}`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if decoded.ID == nil || *decoded.ID != "com.contoso.costCenter" {
+		t.Fatalf("unexpected ID: %v", decoded.ID)
+	}
+	if decoded.Value == nil || len(*decoded.Value) != 2 || (*decoded.Value)["division"] != "R&D" {
+		t.Fatalf("unexpected custom properties: %v", decoded.Value)
+	}
+}
+
+func TestUsersClient_GetWithExtensionsSelect(t *testing.T) {
+	var gotSelect []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSelect = nil
+		if sel := r.URL.Query().Get("$select"); sel != "" {
+			gotSelect = strings.Split(sel, ",")
+		}
+
+		// Like the API, only return extension values when they are selected
+		user := map[string]interface{}{
+			"id":          "11111111-1111-1111-1111-111111111111",
+			"displayName": "Test User",
+		}
+		for _, s := range gotSelect {
+			if s == "extabc123_costCenter" {
+				user["extabc123_costCenter"] = map[string]string{"costCenter": "CC-42"}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(user)
+	}))
+	defer ts.Close()
+
+	client := msgraph.NewUsersClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	schemaExtensions := &[]msgraph.SchemaExtensionData{msgraph.NewSchemaExtensionData("extabc123_costCenter", &testCostCenter{})}
+
+	user, _, err := client.GetWithExtensions(context.Background(), "11111111-1111-1111-1111-111111111111", odata.Query{}, schemaExtensions)
+	if err != nil {
+		t.Fatalf("UsersClient.GetWithExtensions(): %v", err)
+	}
+	expected := []string{"id", "businessPhones", "displayName", "givenName", "jobTitle", "mail", "mobilePhone", "officeLocation",
+		"preferredLanguage", "surname", "userPrincipalName", "extabc123_costCenter"}
+	if !reflect.DeepEqual(gotSelect, expected) {
+		t.Errorf("expected $select %v when none was specified, got %v", expected, gotSelect)
+	}
+	if user.DisplayName == nil || *user.DisplayName != "Test User" {
+		t.Errorf("unexpected DisplayName: %v", user.DisplayName)
+	}
+	costCenter, err := msgraph.GetSchemaExtensionValue[testCostCenter](user.SchemaExtensions, "extabc123_costCenter")
+	if err != nil {
+		t.Fatalf("GetSchemaExtensionValue(): %v", err)
+	}
+	if costCenter == nil || costCenter.CostCenter != "CC-42" {
+		t.Errorf("expected extension value to be returned when no properties were selected, got %+v", costCenter)
+	}
+
+	callerSelect := make([]string, 1, 4)
+	callerSelect[0] = "displayName"
+	if _, _, err = client.GetWithExtensions(context.Background(), "11111111-1111-1111-1111-111111111111", odata.Query{Select: callerSelect}, schemaExtensions); err != nil {
+		t.Fatalf("UsersClient.GetWithExtensions(): %v", err)
+	}
+	if expected := []string{"id", "displayName", "extabc123_costCenter"}; !reflect.DeepEqual(gotSelect, expected) {
+		t.Errorf("expected $select %v, got %v", expected, gotSelect)
+	}
+	if !reflect.DeepEqual(callerSelect, []string{"displayName"}) || cap(callerSelect) != 4 || callerSelect[:2][1] != "" {
+		t.Errorf("caller's select was modified: %v", callerSelect[:cap(callerSelect)])
+	}
+}
@@ -0,0 +1,171 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// ExternalIdentitiesPolicyClient performs operations on the External Identities policy for a tenant.
+type ExternalIdentitiesPolicyClient struct {
+	BaseClient Client
+}
+
+// NewExternalIdentitiesPolicyClient returns a new ExternalIdentitiesPolicyClient.
+func NewExternalIdentitiesPolicyClient() *ExternalIdentitiesPolicyClient {
+	return &ExternalIdentitiesPolicyClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// Get retrieves the External Identities policy.
+func (c *ExternalIdentitiesPolicyClient) Get(ctx context.Context, query odata.Query) (*ExternalIdentitiesPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/policies/externalIdentitiesPolicy",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ExternalIdentitiesPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy ExternalIdentitiesPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// Update amends the External Identities policy.
+func (c *ExternalIdentitiesPolicyClient) Update(ctx context.Context, policy ExternalIdentitiesPolicy) (int, error) {
+	var status int
+
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: "/policies/externalIdentitiesPolicy",
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ExternalIdentitiesPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// B2BManagementPolicyClient performs operations on the B2B management policy for a tenant, which restricts the
+// domains to which B2B invitations can be sent.
+type B2BManagementPolicyClient struct {
+	BaseClient Client
+}
+
+// NewB2BManagementPolicyClient returns a new B2BManagementPolicyClient.
+func NewB2BManagementPolicyClient() *B2BManagementPolicyClient {
+	return &B2BManagementPolicyClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// Get retrieves the B2B management policy.
+func (c *B2BManagementPolicyClient) Get(ctx context.Context) (*B2BManagementPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/identity/b2bManagementPolicy",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("B2BManagementPolicyClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var policy B2BManagementPolicy
+	if err := json.Unmarshal(respBody, &policy); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &policy, status, nil
+}
+
+// Update amends the B2B management policy.
+func (c *B2BManagementPolicyClient) Update(ctx context.Context, policy B2BManagementPolicy) (int, error) {
+	var status int
+
+	if policy.Definition == nil {
+		return status, errors.New("cannot update B2B management policy with nil Definition")
+	}
+
+	body, err := json.Marshal(B2BManagementPolicy{Definition: policy.Definition})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, PatchHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: "/identity/b2bManagementPolicy",
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("B2BManagementPolicyClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// SetAllowedDomains restricts B2B invitations to the specified domains, replacing any existing allow or deny list.
+// An empty slice removes all domain restrictions.
+func (c *B2BManagementPolicyClient) SetAllowedDomains(ctx context.Context, domains []string) (int, error) {
+	return c.setDomains(ctx, InvitationsAllowedAndBlockedDomainsPolicy{
+		AllowedDomains: &domains,
+		BlockedDomains: &[]string{},
+	})
+}
+
+// SetBlockedDomains prevents B2B invitations from being sent to the specified domains, replacing any existing allow or
+// deny list. An empty slice removes all domain restrictions.
+func (c *B2BManagementPolicyClient) SetBlockedDomains(ctx context.Context, domains []string) (int, error) {
+	return c.setDomains(ctx, InvitationsAllowedAndBlockedDomainsPolicy{
+		AllowedDomains: &[]string{},
+		BlockedDomains: &domains,
+	})
+}
+
+func (c *B2BManagementPolicyClient) setDomains(ctx context.Context, domainsPolicy InvitationsAllowedAndBlockedDomainsPolicy) (int, error) {
+	policy, status, err := c.Get(ctx)
+	if err != nil {
+		return status, err
+	}
+
+	if err := policy.SetDomainsPolicy(domainsPolicy); err != nil {
+		return status, fmt.Errorf("B2BManagementPolicy.SetDomainsPolicy(): %v", err)
+	}
+
+	return c.Update(ctx, *policy)
+}
@@ -0,0 +1,67 @@
+package msgraph_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/test"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestExternalIdentitiesPolicyClient(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	policy := testExternalIdentitiesPolicyClient_Get(t, c)
+	testExternalIdentitiesPolicyClient_Update(t, c, msgraph.ExternalIdentitiesPolicy{
+		AllowExternalIdentitiesToLeave: policy.AllowExternalIdentitiesToLeave,
+	})
+}
+
+func TestB2BManagementPolicyClient(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	policy := testB2BManagementPolicyClient_Get(t, c)
+	if _, err := policy.DomainsPolicy(); err != nil {
+		t.Fatalf("B2BManagementPolicy.DomainsPolicy(): %v", err)
+	}
+}
+
+func testExternalIdentitiesPolicyClient_Get(t *testing.T, c *test.Test) (policy *msgraph.ExternalIdentitiesPolicy) {
+	policy, status, err := c.ExternalIdentitiesPolicyClient.Get(c.Context, odata.Query{})
+	if err != nil {
+		t.Fatalf("ExternalIdentitiesPolicyClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ExternalIdentitiesPolicyClient.Get(): invalid status: %d", status)
+	}
+	if policy == nil {
+		t.Fatal("ExternalIdentitiesPolicyClient.Get(): policy was nil")
+	}
+	return
+}
+
+func testExternalIdentitiesPolicyClient_Update(t *testing.T, c *test.Test, p msgraph.ExternalIdentitiesPolicy) {
+	status, err := c.ExternalIdentitiesPolicyClient.Update(c.Context, p)
+	if err != nil {
+		t.Fatalf("ExternalIdentitiesPolicyClient.Update(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ExternalIdentitiesPolicyClient.Update(): invalid status: %d", status)
+	}
+}
+
+func testB2BManagementPolicyClient_Get(t *testing.T, c *test.Test) (policy *msgraph.B2BManagementPolicy) {
+	policy, status, err := c.B2BManagementPolicyClient.Get(c.Context)
+	if err != nil {
+		t.Fatalf("B2BManagementPolicyClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("B2BManagementPolicyClient.Get(): invalid status: %d", status)
+	}
+	if policy == nil {
+		t.Fatal("B2BManagementPolicyClient.Get(): policy was nil")
+	}
+	return
+}
@@ -0,0 +1,364 @@
+package msgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+const (
+	// FederatedIdentityCredentialDefaultAudience is the recommended audience for federated identity credentials.
+	FederatedIdentityCredentialDefaultAudience = "api://AzureADTokenExchange"
+
+	GitHubActionsIssuer  = "https://token.actions.githubusercontent.com"
+	GitLabIssuer         = "https://gitlab.com"
+	TerraformCloudIssuer = "https://app.terraform.io"
+)
+
+// federatedIdentityCredentialNameRegexp matches valid federated identity credential names, which must be between 3 and
+// 120 characters, containing only alphanumeric characters, hyphens and underscores, and beginning with an alphanumeric
+// character.
+var federatedIdentityCredentialNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{2,119}$`)
+
+// federatedIdentityCredentialMaxSubjectLength is the maximum length of a federated identity credential subject.
+const federatedIdentityCredentialMaxSubjectLength = 600
+
+// ValidateFederatedIdentityCredential checks that a FederatedIdentityCredential satisfies the constraints enforced by
+// the API, so that mistakes can be caught before making any requests.
+func ValidateFederatedIdentityCredential(credential FederatedIdentityCredential) error {
+	if credential.Name == nil || !federatedIdentityCredentialNameRegexp.MatchString(*credential.Name) {
+		return errors.New("federated identity credential name must be 3-120 characters, containing only alphanumeric characters, hyphens and underscores, and begin with an alphanumeric character")
+	}
+
+	if credential.Issuer == nil || *credential.Issuer == "" {
+		return fmt.Errorf("federated identity credential %q must have an issuer", *credential.Name)
+	}
+	if u, err := url.Parse(*credential.Issuer); err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("issuer for federated identity credential %q must be an https URL, got %q", *credential.Name, *credential.Issuer)
+	}
+
+	hasSubject := credential.Subject != nil && *credential.Subject != ""
+	hasExpression := credential.ClaimsMatchingExpression != nil && credential.ClaimsMatchingExpression.Value != nil && *credential.ClaimsMatchingExpression.Value != ""
+	if hasSubject == hasExpression {
+		return fmt.Errorf("federated identity credential %q must have exactly one of subject or claimsMatchingExpression", *credential.Name)
+	}
+	if hasSubject && len(*credential.Subject) > federatedIdentityCredentialMaxSubjectLength {
+		return fmt.Errorf("subject for federated identity credential %q must not exceed %d characters", *credential.Name, federatedIdentityCredentialMaxSubjectLength)
+	}
+
+	if credential.Audiences == nil || len(*credential.Audiences) != 1 || (*credential.Audiences)[0] == "" {
+		return fmt.Errorf("federated identity credential %q must have exactly one audience", *credential.Name)
+	}
+
+	return nil
+}
+
+// newFederatedIdentityCredential returns a validated FederatedIdentityCredential having the default audience.
+func newFederatedIdentityCredential(name, issuer, subject string) (*FederatedIdentityCredential, error) {
+	credential := FederatedIdentityCredential{
+		Audiences: &[]string{FederatedIdentityCredentialDefaultAudience},
+		Issuer:    utils.StringPtr(issuer),
+		Name:      utils.StringPtr(name),
+		Subject:   utils.StringPtr(subject),
+	}
+	if err := ValidateFederatedIdentityCredential(credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// NewFederatedIdentityCredentialWithExpression returns a flexible FederatedIdentityCredential, which matches tokens
+// from the specified issuer using a claims matching expression instead of an exact subject, e.g.
+// `claims['sub'] matches 'repo:contoso/*'`. Flexible federated identity credentials are currently only supported by
+// the beta API.
+func NewFederatedIdentityCredentialWithExpression(name, issuer, expression string) (*FederatedIdentityCredential, error) {
+	credential := FederatedIdentityCredential{
+		Audiences: &[]string{FederatedIdentityCredentialDefaultAudience},
+		ClaimsMatchingExpression: &FederatedIdentityExpression{
+			LanguageVersion: utils.Int32Ptr(1),
+			Value:           utils.StringPtr(expression),
+		},
+		Issuer: utils.StringPtr(issuer),
+		Name:   utils.StringPtr(name),
+	}
+	if err := ValidateFederatedIdentityCredential(credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// GitHubActionsFederatedIdentity describes the GitHub Actions workflows permitted to authenticate using a federated
+// identity credential. Value is the environment, branch or tag name, and is not used for pull requests.
+type GitHubActionsFederatedIdentity struct {
+	Organization string
+	Repository   string
+	EntityType   GitHubActionsEntityType
+	Value        string
+}
+
+// Subject returns the subject claim presented by matching GitHub Actions workflows.
+func (i GitHubActionsFederatedIdentity) Subject() (string, error) {
+	if i.Organization == "" || i.Repository == "" {
+		return "", errors.New("GitHub organization and repository must be specified")
+	}
+
+	prefix := fmt.Sprintf("repo:%s/%s", i.Organization, i.Repository)
+	if i.EntityType == GitHubActionsEntityTypePullRequest {
+		return prefix + ":pull_request", nil
+	}
+	if i.Value == "" {
+		return "", fmt.Errorf("a value must be specified for GitHub Actions entity type %q", i.EntityType)
+	}
+
+	switch i.EntityType {
+	case GitHubActionsEntityTypeBranch:
+		return fmt.Sprintf("%s:ref:refs/heads/%s", prefix, i.Value), nil
+	case GitHubActionsEntityTypeEnvironment:
+		return fmt.Sprintf("%s:environment:%s", prefix, i.Value), nil
+	case GitHubActionsEntityTypeTag:
+		return fmt.Sprintf("%s:ref:refs/tags/%s", prefix, i.Value), nil
+	}
+
+	return "", fmt.Errorf("unsupported GitHub Actions entity type %q", i.EntityType)
+}
+
+// NewGitHubActionsFederatedIdentityCredential returns a FederatedIdentityCredential trusting GitHub Actions workflows.
+func NewGitHubActionsFederatedIdentityCredential(name string, identity GitHubActionsFederatedIdentity) (*FederatedIdentityCredential, error) {
+	subject, err := identity.Subject()
+	if err != nil {
+		return nil, err
+	}
+	return newFederatedIdentityCredential(name, GitHubActionsIssuer, subject)
+}
+
+// NewKubernetesFederatedIdentityCredential returns a FederatedIdentityCredential trusting a Kubernetes service
+// account, such as for AKS workload identity. issuer is the OIDC issuer URL of the cluster.
+func NewKubernetesFederatedIdentityCredential(name, issuer, namespace, serviceAccount string) (*FederatedIdentityCredential, error) {
+	if namespace == "" || serviceAccount == "" {
+		return nil, errors.New("Kubernetes namespace and service account must be specified")
+	}
+	return newFederatedIdentityCredential(name, issuer, fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount))
+}
+
+// TerraformCloudFederatedIdentity describes the Terraform Cloud runs permitted to authenticate using a federated
+// identity credential. Hostname defaults to Terraform Cloud and should be set when using Terraform Enterprise, and
+// Project defaults to `Default Project`. A separate credential is required for each run phase.
+type TerraformCloudFederatedIdentity struct {
+	Hostname     string
+	Organization string
+	Project      string
+	Workspace    string
+	RunPhase     TerraformCloudRunPhase
+}
+
+// Subject returns the subject claim presented by matching Terraform Cloud runs.
+func (i TerraformCloudFederatedIdentity) Subject() (string, error) {
+	if i.Organization == "" || i.Workspace == "" {
+		return "", errors.New("Terraform Cloud organization and workspace must be specified")
+	}
+	if i.RunPhase != TerraformCloudRunPhasePlan && i.RunPhase != TerraformCloudRunPhaseApply {
+		return "", fmt.Errorf("unsupported Terraform Cloud run phase %q", i.RunPhase)
+	}
+
+	project := i.Project
+	if project == "" {
+		project = "Default Project"
+	}
+
+	return fmt.Sprintf("organization:%s:project:%s:workspace:%s:run_phase:%s", i.Organization, project, i.Workspace, i.RunPhase), nil
+}
+
+// NewTerraformCloudFederatedIdentityCredential returns a FederatedIdentityCredential trusting Terraform Cloud or
+// Terraform Enterprise runs.
+func NewTerraformCloudFederatedIdentityCredential(name string, identity TerraformCloudFederatedIdentity) (*FederatedIdentityCredential, error) {
+	subject, err := identity.Subject()
+	if err != nil {
+		return nil, err
+	}
+
+	issuer := TerraformCloudIssuer
+	if identity.Hostname != "" {
+		issuer = "https://" + strings.TrimPrefix(identity.Hostname, "https://")
+	}
+
+	return newFederatedIdentityCredential(name, issuer, subject)
+}
+
+// GitLabFederatedIdentity describes the GitLab CI/CD jobs permitted to authenticate using a federated identity
+// credential. InstanceUrl defaults to GitLab.com and should be set for self-managed instances. ProjectPath is the full
+// path of the project, including any groups.
+type GitLabFederatedIdentity struct {
+	InstanceUrl string
+	ProjectPath string
+	RefType     GitLabRefType
+	Ref         string
+}
+
+// Subject returns the subject claim presented by matching GitLab CI/CD jobs.
+func (i GitLabFederatedIdentity) Subject() (string, error) {
+	if i.ProjectPath == "" || i.Ref == "" {
+		return "", errors.New("GitLab project path and ref must be specified")
+	}
+	if i.RefType != GitLabRefTypeBranch && i.RefType != GitLabRefTypeTag {
+		return "", fmt.Errorf("unsupported GitLab ref type %q", i.RefType)
+	}
+
+	return fmt.Sprintf("project_path:%s:ref_type:%s:ref:%s", i.ProjectPath, i.RefType, i.Ref), nil
+}
+
+// NewGitLabFederatedIdentityCredential returns a FederatedIdentityCredential trusting GitLab CI/CD jobs.
+func NewGitLabFederatedIdentityCredential(name string, identity GitLabFederatedIdentity) (*FederatedIdentityCredential, error) {
+	subject, err := identity.Subject()
+	if err != nil {
+		return nil, err
+	}
+
+	issuer := GitLabIssuer
+	if identity.InstanceUrl != "" {
+		issuer = strings.TrimSuffix(identity.InstanceUrl, "/")
+	}
+
+	return newFederatedIdentityCredential(name, issuer, subject)
+}
+
+// FederatedIdentityCredentialsReconcileResult lists the names of federated identity credentials affected by
+// ReconcileFederatedIdentityCredentials().
+type FederatedIdentityCredentialsReconcileResult struct {
+	Created   []string
+	Updated   []string
+	Replaced  []string
+	Deleted   []string
+	Unchanged []string
+}
+
+// ReconcileFederatedIdentityCredentials idempotently ensures that an Application has the desired federated identity
+// credentials, matched by name. Missing credentials are created and differing credentials are updated. A credential
+// which switches between matching on Subject and matching on ClaimsMatchingExpression cannot be updated in place, so it
+// is deleted and recreated, and is briefly absent in the meantime. When prune is true, credentials which are not desired
+// are deleted. All desired credentials are validated before any changes are made.
+func (c *ApplicationsClient) ReconcileFederatedIdentityCredentials(ctx context.Context, applicationId string, desired []FederatedIdentityCredential, prune bool) (*FederatedIdentityCredentialsReconcileResult, int, error) {
+	var status int
+
+	desiredNames := make(map[string]bool, len(desired))
+	for _, credential := range desired {
+		if err := ValidateFederatedIdentityCredential(credential); err != nil {
+			return nil, status, err
+		}
+		if desiredNames[*credential.Name] {
+			return nil, status, fmt.Errorf("duplicate federated identity credential name %q", *credential.Name)
+		}
+		desiredNames[*credential.Name] = true
+	}
+
+	existing, status, err := c.ListFederatedIdentityCredentials(ctx, applicationId, odata.Query{})
+	if err != nil {
+		return nil, status, err
+	}
+
+	existingByName := make(map[string]FederatedIdentityCredential)
+	if existing != nil {
+		for _, credential := range *existing {
+			if credential.Name != nil {
+				existingByName[*credential.Name] = credential
+			}
+		}
+	}
+
+	result := FederatedIdentityCredentialsReconcileResult{
+		Created:   make([]string, 0),
+		Updated:   make([]string, 0),
+		Replaced:  make([]string, 0),
+		Deleted:   make([]string, 0),
+		Unchanged: make([]string, 0),
+	}
+
+	for _, credential := range desired {
+		current, ok := existingByName[*credential.Name]
+		if !ok {
+			if _, status, err = c.CreateFederatedIdentityCredential(ctx, applicationId, credential); err != nil {
+				return &result, status, err
+			}
+			result.Created = append(result.Created, *credential.Name)
+			continue
+		}
+
+		if federatedIdentityCredentialsEqual(current, credential) {
+			result.Unchanged = append(result.Unchanged, *credential.Name)
+			continue
+		}
+
+		if federatedIdentityCredentialUsesExpression(current) != federatedIdentityCredentialUsesExpression(credential) && current.ID != nil {
+			if status, err = c.DeleteFederatedIdentityCredential(ctx, applicationId, *current.ID); err != nil {
+				return &result, status, err
+			}
+			if _, status, err = c.CreateFederatedIdentityCredential(ctx, applicationId, credential); err != nil {
+				return &result, status, fmt.Errorf("federated identity credential %q was deleted but could not be recreated: %v", *credential.Name, err)
+			}
+			result.Replaced = append(result.Replaced, *credential.Name)
+			continue
+		}
+
+		credential.ID = current.ID
+		if status, err = c.UpdateFederatedIdentityCredential(ctx, applicationId, credential); err != nil {
+			return &result, status, err
+		}
+		result.Updated = append(result.Updated, *credential.Name)
+	}
+
+	if prune {
+		for name, credential := range existingByName {
+			if desiredNames[name] || credential.ID == nil {
+				continue
+			}
+			if status, err = c.DeleteFederatedIdentityCredential(ctx, applicationId, *credential.ID); err != nil {
+				return &result, status, err
+			}
+			result.Deleted = append(result.Deleted, name)
+		}
+		sort.Strings(result.Deleted)
+	}
+
+	return &result, status, nil
+}
+
+// federatedIdentityCredentialUsesExpression returns whether a federated identity credential matches tokens using a
+// ClaimsMatchingExpression rather than a Subject.
+func federatedIdentityCredentialUsesExpression(credential FederatedIdentityCredential) bool {
+	return credential.ClaimsMatchingExpression != nil && credential.ClaimsMatchingExpression.Value != nil && *credential.ClaimsMatchingExpression.Value != ""
+}
+
+// federatedIdentityCredentialsEqual compares the configurable fields of two federated identity credentials.
+func federatedIdentityCredentialsEqual(a, b FederatedIdentityCredential) bool {
+	strNullWhenEmpty := func(v *StringNullWhenEmpty) string {
+		if v == nil {
+			return ""
+		}
+		return string(*v)
+	}
+	expression := func(v *FederatedIdentityExpression) string {
+		if v == nil {
+			return ""
+		}
+		return stringValue(v.Value)
+	}
+	audiences := func(v *[]string) []string {
+		if v == nil {
+			return []string{}
+		}
+		return *v
+	}
+
+	return stringValue(a.Issuer) == stringValue(b.Issuer) &&
+		stringValue(a.Subject) == stringValue(b.Subject) &&
+		expression(a.ClaimsMatchingExpression) == expression(b.ClaimsMatchingExpression) &&
+		strNullWhenEmpty(a.Description) == strNullWhenEmpty(b.Description) &&
+		reflect.DeepEqual(audiences(a.Audiences), audiences(b.Audiences))
+}
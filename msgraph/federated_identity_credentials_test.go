@@ -0,0 +1,199 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+func TestFederatedIdentityCredentialSubjects(t *testing.T) {
+	testCases := []struct {
+		name     string
+		subject  func() (string, error)
+		expected string
+		err      bool
+	}{
+		{
+			name:     "GitHubEnvironment",
+			subject:  GitHubActionsFederatedIdentity{Organization: "contoso", Repository: "infra", EntityType: GitHubActionsEntityTypeEnvironment, Value: "production"}.Subject,
+			expected: "repo:contoso/infra:environment:production",
+		},
+		{
+			name:     "GitHubBranch",
+			subject:  GitHubActionsFederatedIdentity{Organization: "contoso", Repository: "infra", EntityType: GitHubActionsEntityTypeBranch, Value: "main"}.Subject,
+			expected: "repo:contoso/infra:ref:refs/heads/main",
+		},
+		{
+			name:     "GitHubTag",
+			subject:  GitHubActionsFederatedIdentity{Organization: "contoso", Repository: "infra", EntityType: GitHubActionsEntityTypeTag, Value: "v1.0.0"}.Subject,
+			expected: "repo:contoso/infra:ref:refs/tags/v1.0.0",
+		},
+		{
+			name:     "GitHubPullRequest",
+			subject:  GitHubActionsFederatedIdentity{Organization: "contoso", Repository: "infra", EntityType: GitHubActionsEntityTypePullRequest}.Subject,
+			expected: "repo:contoso/infra:pull_request",
+		},
+		{
+			name:    "GitHubMissingValue",
+			subject: GitHubActionsFederatedIdentity{Organization: "contoso", Repository: "infra", EntityType: GitHubActionsEntityTypeBranch}.Subject,
+			err:     true,
+		},
+		{
+			name:     "TerraformCloudDefaultProject",
+			subject:  TerraformCloudFederatedIdentity{Organization: "contoso", Workspace: "networking", RunPhase: TerraformCloudRunPhasePlan}.Subject,
+			expected: "organization:contoso:project:Default Project:workspace:networking:run_phase:plan",
+		},
+		{
+			name:    "TerraformCloudInvalidRunPhase",
+			subject: TerraformCloudFederatedIdentity{Organization: "contoso", Workspace: "networking", RunPhase: "destroy"}.Subject,
+			err:     true,
+		},
+		{
+			name:     "GitLabBranch",
+			subject:  GitLabFederatedIdentity{ProjectPath: "contoso/platform/infra", RefType: GitLabRefTypeBranch, Ref: "main"}.Subject,
+			expected: "project_path:contoso/platform/infra:ref_type:branch:ref:main",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			subject, err := tc.subject()
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected error, got subject %q", subject)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if subject != tc.expected {
+				t.Fatalf("expected subject %q, got %q", tc.expected, subject)
+			}
+		})
+	}
+}
+
+func TestValidateFederatedIdentityCredential(t *testing.T) {
+	credential, err := NewKubernetesFederatedIdentityCredential("aks-workload", "https://eastus.oic.prod-aks.azure.com/tenant/cluster/", "default", "workload")
+	if err != nil {
+		t.Fatalf("NewKubernetesFederatedIdentityCredential(): %v", err)
+	}
+	if *credential.Subject != "system:serviceaccount:default:workload" {
+		t.Errorf("unexpected subject: %q", *credential.Subject)
+	}
+
+	if _, err := NewFederatedIdentityCredentialWithExpression("github-all-branches", GitHubActionsIssuer, "claims['sub'] matches 'repo:contoso/infra:ref:refs/heads/*'"); err != nil {
+		t.Errorf("NewFederatedIdentityCredentialWithExpression(): %v", err)
+	}
+
+	invalid := *credential
+	invalid.Name = utils.StringPtr("-invalid")
+	if err := ValidateFederatedIdentityCredential(invalid); err == nil {
+		t.Error("expected error for invalid name")
+	}
+
+	invalid = *credential
+	invalid.Issuer = utils.StringPtr("http://insecure.example.com")
+	if err := ValidateFederatedIdentityCredential(invalid); err == nil {
+		t.Error("expected error for non-https issuer")
+	}
+
+	invalid = *credential
+	invalid.ClaimsMatchingExpression = &FederatedIdentityExpression{Value: utils.StringPtr("claims['sub'] eq 'foo'")}
+	if err := ValidateFederatedIdentityCredential(invalid); err == nil {
+		t.Error("expected error when both subject and claimsMatchingExpression are specified")
+	}
+
+	changed := *credential
+	if !federatedIdentityCredentialsEqual(*credential, changed) {
+		t.Error("expected identical credentials to be equal")
+	}
+	changed.Subject = utils.StringPtr("system:serviceaccount:default:other")
+	if federatedIdentityCredentialsEqual(*credential, changed) {
+		t.Error("expected credentials with different subjects to differ")
+	}
+}
+
+func TestApplicationsClient_ReconcileFederatedIdentityCredentials(t *testing.T) {
+	const basePath = "/beta/applications/11111111-1111-1111-1111-111111111111/federatedIdentityCredentials"
+
+	requests := make([]string, 0)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request body: %v", err)
+		}
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, strings.TrimPrefix(r.URL.Path, basePath), body))
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"value": [
+				{"id": "cred-unchanged", "name": "unchanged", "issuer": "https://token.actions.githubusercontent.com", "subject": "repo:contoso/infra:ref:refs/heads/main", "audiences": ["api://AzureADTokenExchange"]},
+				{"id": "cred-update", "name": "update", "issuer": "https://token.actions.githubusercontent.com", "subject": "repo:contoso/infra:ref:refs/heads/main", "audiences": ["api://AzureADTokenExchange"]},
+				{"id": "cred-replace", "name": "replace", "issuer": "https://token.actions.githubusercontent.com", "subject": "repo:contoso/infra:ref:refs/heads/main", "audiences": ["api://AzureADTokenExchange"]}
+			]}`))
+		case http.MethodPost:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write(body)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewApplicationsClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	unchanged, err := newFederatedIdentityCredential("unchanged", GitHubActionsIssuer, "repo:contoso/infra:ref:refs/heads/main")
+	if err != nil {
+		t.Fatalf("newFederatedIdentityCredential(): %v", err)
+	}
+	update, err := newFederatedIdentityCredential("update", GitHubActionsIssuer, "repo:contoso/infra:ref:refs/heads/release")
+	if err != nil {
+		t.Fatalf("newFederatedIdentityCredential(): %v", err)
+	}
+	replace, err := NewFederatedIdentityCredentialWithExpression("replace", GitHubActionsIssuer, "claims['sub'] matches 'repo:contoso/infra:ref:refs/heads/*'")
+	if err != nil {
+		t.Fatalf("NewFederatedIdentityCredentialWithExpression(): %v", err)
+	}
+
+	result, _, err := client.ReconcileFederatedIdentityCredentials(context.Background(), "11111111-1111-1111-1111-111111111111", []FederatedIdentityCredential{*unchanged, *update, *replace}, false)
+	if err != nil {
+		t.Fatalf("ReconcileFederatedIdentityCredentials(): %v", err)
+	}
+
+	expected := FederatedIdentityCredentialsReconcileResult{
+		Created:   []string{},
+		Updated:   []string{"update"},
+		Replaced:  []string{"replace"},
+		Deleted:   []string{},
+		Unchanged: []string{"unchanged"},
+	}
+	if !reflect.DeepEqual(*result, expected) {
+		t.Errorf("ReconcileFederatedIdentityCredentials(): expected result %+v, got %+v", expected, *result)
+	}
+
+	if len(requests) != 4 {
+		t.Fatalf("ReconcileFederatedIdentityCredentials(): expected 4 requests, got %d: %v", len(requests), requests)
+	}
+	if !strings.HasPrefix(requests[1], "PATCH /cred-update ") || !strings.Contains(requests[1], `"subject":"repo:contoso/infra:ref:refs/heads/release"`) {
+		t.Errorf("ReconcileFederatedIdentityCredentials(): expected in-place update of subject, got %q", requests[1])
+	}
+	if requests[2] != "DELETE /cred-replace " {
+		t.Errorf("ReconcileFederatedIdentityCredentials(): expected credential switching to an expression to be deleted, got %q", requests[2])
+	}
+	if !strings.HasPrefix(requests[3], "POST  ") || !strings.Contains(requests[3], `"claimsMatchingExpression"`) || strings.Contains(requests[3], `"subject"`) {
+		t.Errorf("ReconcileFederatedIdentityCredentials(): expected credential to be recreated with an expression, got %q", requests[3])
+	}
+}
@@ -0,0 +1,318 @@
+package msgraph
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SAML protocol bindings used in federation metadata endpoints
+const (
+	SamlBindingHttpPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	SamlBindingHttpRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+)
+
+const (
+	federationMetadataNamespace          = "urn:oasis:names:tc:SAML:2.0:metadata"
+	federationMetadataSignatureNamespace = "http://www.w3.org/2000/09/xmldsig#"
+	federationMetadataProtocol           = "urn:oasis:names:tc:SAML:2.0:protocol"
+)
+
+// FederationMetadata describes the SAML 2.0 federation metadata for an identity provider or service provider. Use
+// ParseFederationMetadata() to read metadata provided by a service provider, and XML() to generate metadata.
+type FederationMetadata struct {
+	EntityId string
+	Role     FederationMetadataRole
+
+	// SigningCertificates and EncryptionCertificates are base64 encoded DER certificates
+	SigningCertificates    []string
+	EncryptionCertificates []string
+
+	NameIdFormats []string
+
+	// SingleSignOnServices are only present for identity providers
+	SingleSignOnServices []FederationMetadataEndpoint
+
+	// AssertionConsumerServices are only present for service providers
+	AssertionConsumerServices []FederationMetadataEndpoint
+
+	SingleLogoutServices []FederationMetadataEndpoint
+}
+
+// FederationMetadataEndpoint describes a SAML endpoint in federation metadata. Index and IsDefault are only used for
+// assertion consumer services.
+type FederationMetadataEndpoint struct {
+	Binding   string
+	Location  string
+	Index     *int
+	IsDefault *bool
+}
+
+// NewEntraFederationMetadata returns identity provider federation metadata for SAML applications in the specified
+// tenant, suitable for providing to a service provider. certificates are the base64 encoded DER certificates used for
+// token signing, such as the Key of a KeyCredential returned by ServicePrincipalsClient.AddTokenSigningCertificate().
+func NewEntraFederationMetadata(tenantId string, certificates ...string) FederationMetadata {
+	endpoint := fmt.Sprintf("https://login.microsoftonline.com/%s/saml2", tenantId)
+	return FederationMetadata{
+		EntityId:            fmt.Sprintf("https://sts.windows.net/%s/", tenantId),
+		Role:                FederationMetadataRoleIdentityProvider,
+		SigningCertificates: certificates,
+		SingleSignOnServices: []FederationMetadataEndpoint{
+			{Binding: SamlBindingHttpRedirect, Location: endpoint},
+			{Binding: SamlBindingHttpPost, Location: endpoint},
+		},
+		SingleLogoutServices: []FederationMetadataEndpoint{
+			{Binding: SamlBindingHttpRedirect, Location: endpoint},
+		},
+	}
+}
+
+// ParseFederationMetadata parses SAML 2.0 federation metadata XML containing an EntityDescriptor, or an
+// EntitiesDescriptor in which case the first entity is returned.
+func ParseFederationMetadata(data []byte) (*FederationMetadata, error) {
+	var entities struct {
+		XMLName  xml.Name
+		Entities []federationMetadataEntityDescriptor `xml:"EntityDescriptor"`
+	}
+	if err := xml.Unmarshal(data, &entities); err != nil {
+		return nil, fmt.Errorf("xml.Unmarshal(): %v", err)
+	}
+
+	var entity federationMetadataEntityDescriptor
+	switch entities.XMLName.Local {
+	case "EntitiesDescriptor":
+		if len(entities.Entities) == 0 {
+			return nil, errors.New("federation metadata contains no EntityDescriptor")
+		}
+		entity = entities.Entities[0]
+	case "EntityDescriptor":
+		if err := xml.Unmarshal(data, &entity); err != nil {
+			return nil, fmt.Errorf("xml.Unmarshal(): %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected root element %q in federation metadata", entities.XMLName.Local)
+	}
+
+	ret := FederationMetadata{
+		EntityId: entity.EntityId,
+	}
+
+	var descriptor *federationMetadataRoleDescriptor
+	switch {
+	case entity.IdpDescriptor != nil:
+		ret.Role = FederationMetadataRoleIdentityProvider
+		descriptor = entity.IdpDescriptor
+	case entity.SpDescriptor != nil:
+		ret.Role = FederationMetadataRoleServiceProvider
+		descriptor = entity.SpDescriptor
+	default:
+		return nil, errors.New("federation metadata contains no IDPSSODescriptor or SPSSODescriptor")
+	}
+
+	for _, key := range descriptor.KeyDescriptors {
+		certificate := strings.Join(strings.Fields(key.Certificate), "")
+		if certificate == "" {
+			continue
+		}
+		if key.Use == "" || key.Use == "signing" {
+			ret.SigningCertificates = append(ret.SigningCertificates, certificate)
+		}
+		if key.Use == "" || key.Use == "encryption" {
+			ret.EncryptionCertificates = append(ret.EncryptionCertificates, certificate)
+		}
+	}
+
+	for _, format := range descriptor.NameIdFormats {
+		ret.NameIdFormats = append(ret.NameIdFormats, strings.TrimSpace(format))
+	}
+
+	ret.SingleSignOnServices = federationMetadataEndpoints(descriptor.SingleSignOnServices)
+	ret.AssertionConsumerServices = federationMetadataEndpoints(descriptor.AssertionConsumerServices)
+	ret.SingleLogoutServices = federationMetadataEndpoints(descriptor.SingleLogoutServices)
+
+	return &ret, nil
+}
+
+// Certificates parses and returns the signing certificates.
+func (m FederationMetadata) Certificates() ([]*x509.Certificate, error) {
+	ret := make([]*x509.Certificate, 0, len(m.SigningCertificates))
+	for _, c := range m.SigningCertificates {
+		der, err := base64.StdEncoding.DecodeString(c)
+		if err != nil {
+			return nil, fmt.Errorf("decoding certificate: %v", err)
+		}
+		certificate, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("x509.ParseCertificate(): %v", err)
+		}
+		ret = append(ret, certificate)
+	}
+	return ret, nil
+}
+
+// SamlSingleSignOnConfiguration returns a SamlSingleSignOnConfiguration populated from service provider metadata, for
+// use with ServicePrincipalsClient.ConfigureSamlSingleSignOn(). The default assertion consumer service is listed first.
+func (m FederationMetadata) SamlSingleSignOnConfiguration() (*SamlSingleSignOnConfiguration, error) {
+	if m.Role != FederationMetadataRoleServiceProvider {
+		return nil, errors.New("federation metadata does not describe a service provider")
+	}
+
+	ret := SamlSingleSignOnConfiguration{
+		IdentifierUris: []string{m.EntityId},
+		ReplyUrls:      make([]string, 0, len(m.AssertionConsumerServices)),
+	}
+
+	for _, acs := range m.AssertionConsumerServices {
+		if acs.IsDefault != nil && *acs.IsDefault {
+			ret.ReplyUrls = append([]string{acs.Location}, ret.ReplyUrls...)
+		} else {
+			ret.ReplyUrls = append(ret.ReplyUrls, acs.Location)
+		}
+	}
+
+	if len(m.SingleLogoutServices) > 0 {
+		location := m.SingleLogoutServices[0].Location
+		ret.LogoutUrl = &location
+	}
+
+	return &ret, nil
+}
+
+// XML returns the federation metadata as an XML document.
+func (m FederationMetadata) XML() ([]byte, error) {
+	if m.EntityId == "" {
+		return nil, errors.New("cannot generate federation metadata with empty EntityId")
+	}
+
+	descriptor := federationMetadataRoleDescriptorXml{
+		ProtocolSupportEnumeration: federationMetadataProtocol,
+		NameIdFormats:              m.NameIdFormats,
+		SingleLogoutServices:       newFederationMetadataEndpointsXml(m.SingleLogoutServices),
+		SingleSignOnServices:       newFederationMetadataEndpointsXml(m.SingleSignOnServices),
+		AssertionConsumerServices:  newFederationMetadataEndpointsXml(m.AssertionConsumerServices),
+	}
+	for _, c := range m.SigningCertificates {
+		descriptor.KeyDescriptors = append(descriptor.KeyDescriptors, newFederationMetadataKeyDescriptorXml("signing", c))
+	}
+	for _, c := range m.EncryptionCertificates {
+		descriptor.KeyDescriptors = append(descriptor.KeyDescriptors, newFederationMetadataKeyDescriptorXml("encryption", c))
+	}
+
+	entity := federationMetadataEntityDescriptorXml{
+		XmlnsMd:  federationMetadataNamespace,
+		XmlnsDs:  federationMetadataSignatureNamespace,
+		EntityId: m.EntityId,
+	}
+	switch m.Role {
+	case FederationMetadataRoleIdentityProvider:
+		entity.IdpDescriptor = &descriptor
+	case FederationMetadataRoleServiceProvider:
+		entity.SpDescriptor = &descriptor
+	default:
+		return nil, fmt.Errorf("unsupported federation metadata role %q", m.Role)
+	}
+
+	out, err := xml.MarshalIndent(entity, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("xml.MarshalIndent(): %v", err)
+	}
+
+	return append([]byte(xml.Header), out...), nil
+}
+
+// federationMetadataEntityDescriptor and related types are used to parse metadata, matching elements by local name
+// regardless of namespace prefix.
+type federationMetadataEntityDescriptor struct {
+	EntityId      string                            `xml:"entityID,attr"`
+	IdpDescriptor *federationMetadataRoleDescriptor `xml:"IDPSSODescriptor"`
+	SpDescriptor  *federationMetadataRoleDescriptor `xml:"SPSSODescriptor"`
+}
+
+type federationMetadataRoleDescriptor struct {
+	KeyDescriptors []struct {
+		Use         string `xml:"use,attr"`
+		Certificate string `xml:"KeyInfo>X509Data>X509Certificate"`
+	} `xml:"KeyDescriptor"`
+	NameIdFormats             []string                     `xml:"NameIDFormat"`
+	SingleSignOnServices      []federationMetadataEndpoint `xml:"SingleSignOnService"`
+	AssertionConsumerServices []federationMetadataEndpoint `xml:"AssertionConsumerService"`
+	SingleLogoutServices      []federationMetadataEndpoint `xml:"SingleLogoutService"`
+}
+
+type federationMetadataEndpoint struct {
+	Binding   string `xml:"Binding,attr"`
+	Location  string `xml:"Location,attr"`
+	Index     *int   `xml:"index,attr"`
+	IsDefault *bool  `xml:"isDefault,attr"`
+}
+
+func federationMetadataEndpoints(in []federationMetadataEndpoint) []FederationMetadataEndpoint {
+	if len(in) == 0 {
+		return nil
+	}
+	ret := make([]FederationMetadataEndpoint, 0, len(in))
+	for _, e := range in {
+		ret = append(ret, FederationMetadataEndpoint{
+			Binding:   e.Binding,
+			Location:  e.Location,
+			Index:     e.Index,
+			IsDefault: e.IsDefault,
+		})
+	}
+	return ret
+}
+
+// federationMetadataEntityDescriptorXml and related types are used to generate metadata with conventional namespace
+// prefixes, which some service providers require.
+type federationMetadataEntityDescriptorXml struct {
+	XMLName       xml.Name                             `xml:"md:EntityDescriptor"`
+	XmlnsMd       string                               `xml:"xmlns:md,attr"`
+	XmlnsDs       string                               `xml:"xmlns:ds,attr"`
+	EntityId      string                               `xml:"entityID,attr"`
+	IdpDescriptor *federationMetadataRoleDescriptorXml `xml:"md:IDPSSODescriptor,omitempty"`
+	SpDescriptor  *federationMetadataRoleDescriptorXml `xml:"md:SPSSODescriptor,omitempty"`
+}
+
+type federationMetadataRoleDescriptorXml struct {
+	ProtocolSupportEnumeration string                            `xml:"protocolSupportEnumeration,attr"`
+	KeyDescriptors             []federationMetadataKeyDescriptor `xml:"md:KeyDescriptor"`
+	SingleLogoutServices       []federationMetadataEndpointXml   `xml:"md:SingleLogoutService"`
+	NameIdFormats              []string                          `xml:"md:NameIDFormat"`
+	SingleSignOnServices       []federationMetadataEndpointXml   `xml:"md:SingleSignOnService"`
+	AssertionConsumerServices  []federationMetadataEndpointXml   `xml:"md:AssertionConsumerService"`
+}
+
+type federationMetadataKeyDescriptor struct {
+	Use         string `xml:"use,attr"`
+	Certificate string `xml:"ds:KeyInfo>ds:X509Data>ds:X509Certificate"`
+}
+
+type federationMetadataEndpointXml struct {
+	Binding   string `xml:"Binding,attr"`
+	Location  string `xml:"Location,attr"`
+	Index     *int   `xml:"index,attr,omitempty"`
+	IsDefault *bool  `xml:"isDefault,attr,omitempty"`
+}
+
+func newFederationMetadataKeyDescriptorXml(use, certificate string) federationMetadataKeyDescriptor {
+	return federationMetadataKeyDescriptor{
+		Use:         use,
+		Certificate: certificate,
+	}
+}
+
+func newFederationMetadataEndpointsXml(in []FederationMetadataEndpoint) []federationMetadataEndpointXml {
+	ret := make([]federationMetadataEndpointXml, 0, len(in))
+	for _, e := range in {
+		ret = append(ret, federationMetadataEndpointXml{
+			Binding:   e.Binding,
+			Location:  e.Location,
+			Index:     e.Index,
+			IsDefault: e.IsDefault,
+		})
+	}
+	return ret
+}
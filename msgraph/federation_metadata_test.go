@@ -0,0 +1,99 @@
+package msgraph
+
+import (
+	"encoding/base64"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testServiceProviderFederationMetadata = `<?xml version="1.0"?>
+<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata">
+  <EntityDescriptor entityID="https://sp.example.com/saml">
+    <SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+      <KeyDescriptor>
+        <KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#">
+          <X509Data>
+            <X509Certificate>
+              QUJD
+              REVG
+            </X509Certificate>
+          </X509Data>
+        </KeyInfo>
+      </KeyDescriptor>
+      <SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://sp.example.com/saml/logout"/>
+      <NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</NameIDFormat>
+      <AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/saml/acs/secondary" index="1"/>
+      <AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/saml/acs" index="0" isDefault="true"/>
+    </SPSSODescriptor>
+  </EntityDescriptor>
+</EntitiesDescriptor>`
+
+func TestParseFederationMetadata(t *testing.T) {
+	metadata, err := ParseFederationMetadata([]byte(testServiceProviderFederationMetadata))
+	if err != nil {
+		t.Fatalf("ParseFederationMetadata(): %v", err)
+	}
+
+	if metadata.EntityId != "https://sp.example.com/saml" {
+		t.Errorf("unexpected EntityId: %q", metadata.EntityId)
+	}
+	if metadata.Role != FederationMetadataRoleServiceProvider {
+		t.Errorf("unexpected Role: %q", metadata.Role)
+	}
+	if !reflect.DeepEqual(metadata.SigningCertificates, []string{"QUJDREVG"}) || !reflect.DeepEqual(metadata.EncryptionCertificates, []string{"QUJDREVG"}) {
+		t.Errorf("unexpected certificates: signing %v, encryption %v", metadata.SigningCertificates, metadata.EncryptionCertificates)
+	}
+	if len(metadata.AssertionConsumerServices) != 2 || metadata.AssertionConsumerServices[1].Index == nil || *metadata.AssertionConsumerServices[1].Index != 0 {
+		t.Errorf("unexpected AssertionConsumerServices: %+v", metadata.AssertionConsumerServices)
+	}
+
+	config, err := metadata.SamlSingleSignOnConfiguration()
+	if err != nil {
+		t.Fatalf("FederationMetadata.SamlSingleSignOnConfiguration(): %v", err)
+	}
+	if !reflect.DeepEqual(config.IdentifierUris, []string{"https://sp.example.com/saml"}) {
+		t.Errorf("unexpected IdentifierUris: %v", config.IdentifierUris)
+	}
+	if !reflect.DeepEqual(config.ReplyUrls, []string{"https://sp.example.com/saml/acs", "https://sp.example.com/saml/acs/secondary"}) {
+		t.Errorf("unexpected ReplyUrls: %v", config.ReplyUrls)
+	}
+	if config.LogoutUrl == nil || *config.LogoutUrl != "https://sp.example.com/saml/logout" {
+		t.Errorf("unexpected LogoutUrl: %v", config.LogoutUrl)
+	}
+}
+
+func TestFederationMetadata_XML(t *testing.T) {
+	signer := testKeyCredentialsCertificate(t)
+	certificate := base64.StdEncoding.EncodeToString(signer.Certificate.Raw)
+	tenantId := "11111111-1111-1111-1111-111111111111"
+
+	metadata := NewEntraFederationMetadata(tenantId, certificate)
+	out, err := metadata.XML()
+	if err != nil {
+		t.Fatalf("FederationMetadata.XML(): %v", err)
+	}
+	if !strings.Contains(string(out), `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata"`) {
+		t.Errorf("expected md namespace prefix in generated metadata:\n%s", out)
+	}
+
+	parsed, err := ParseFederationMetadata(out)
+	if err != nil {
+		t.Fatalf("ParseFederationMetadata(): %v", err)
+	}
+	if !reflect.DeepEqual(metadata, *parsed) {
+		t.Errorf("federation metadata did not round trip\nexpected: %+v\ngot:      %+v", metadata, *parsed)
+	}
+
+	certificates, err := parsed.Certificates()
+	if err != nil {
+		t.Fatalf("FederationMetadata.Certificates(): %v", err)
+	}
+	if len(certificates) != 1 || !certificates[0].Equal(signer.Certificate) {
+		t.Errorf("unexpected certificates parsed from generated metadata")
+	}
+
+	if _, err = parsed.SamlSingleSignOnConfiguration(); err == nil {
+		t.Errorf("expected error obtaining SAML configuration from identity provider metadata")
+	}
+}
@@ -6,10 +6,21 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 )
 
+// defaultGroupProperties are the properties of a Group returned by the API when no properties are selected.
+var defaultGroupProperties = []string{
+	"classification", "createdDateTime", "creationOptions", "deletedDateTime", "description", "displayName",
+	"expirationDateTime", "groupTypes", "id", "isAssignableToRole", "mail", "mailEnabled", "mailNickname",
+	"membershipRule", "membershipRuleProcessingState", "onPremisesDomainName", "onPremisesLastSyncDateTime",
+	"onPremisesNetBiosName", "onPremisesSamAccountName", "onPremisesSecurityIdentifier", "onPremisesSyncEnabled",
+	"preferredDataLocation", "preferredLanguage", "proxyAddresses", "renewedDateTime", "resourceBehaviorOptions",
+	"resourceProvisioningOptions", "securityEnabled", "securityIdentifier", "theme", "visibility",
+}
+
 // GroupsClient performs operations on Groups.
 type GroupsClient struct {
 	BaseClient Client
@@ -125,7 +136,8 @@ func (c *GroupsClient) Get(ctx context.Context, id string, query odata.Query) (*
 	return &group, status, nil
 }
 
-// GetWithSchemaExtensions retrieves a Group, including the values for any specified schema extensions
+// GetWithSchemaExtensions retrieves a Group, including the values for any specified schema extensions.
+// This makes two requests, see GetWithExtensions for a more efficient alternative.
 func (c *GroupsClient) GetWithSchemaExtensions(ctx context.Context, id string, query odata.Query, schemaExtensions *[]SchemaExtensionData) (*Group, int, error) {
 	var sel []string
 	if len(query.Select) > 0 {
@@ -169,6 +181,39 @@ func (c *GroupsClient) GetWithSchemaExtensions(ctx context.Context, id string, q
 	return group, status, nil
 }
 
+// GetWithExtensions retrieves a Group in a single request, including the values for any specified schema extensions
+// or directory extensions. The IDs of the extensions are merged into query.Select, or into the default properties when
+// query.Select is empty, since extension values are only returned when selected. Use NewSchemaExtensionData() and
+// GetSchemaExtensionValue() for typed values.
+func (c *GroupsClient) GetWithExtensions(ctx context.Context, id string, query odata.Query, schemaExtensions *[]SchemaExtensionData) (*Group, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  selectSchemaExtensions(query, schemaExtensions, defaultGroupProperties),
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/groups/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("GroupsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	group := Group{
+		SchemaExtensions: schemaExtensions,
+	}
+	if err := json.Unmarshal(respBody, &group); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &group, status, nil
+}
+
 // GetDeleted retrieves a deleted O365 Group.
 func (c *GroupsClient) GetDeleted(ctx context.Context, id string, query odata.Query) (*Group, int, error) {
 	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
@@ -358,6 +403,37 @@ func (c *GroupsClient) ListMembers(ctx context.Context, id string) (*[]string, i
 	return &ret, status, nil
 }
 
+// ListMemberEntities retrieves the members of the specified Group, optionally queried using OData.
+// id is the object ID of the group.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *GroupsClient) ListMemberEntities(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/groups/%s/members", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("GroupsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
 // GetTransitiveMembers retrieves all nested members of the specified Group.
 // id is the object ID of the group.
 func (c *GroupsClient) GetTransitiveMembers(ctx context.Context, groupId string, query odata.Query) (*[]User, int, error) {
@@ -434,6 +510,37 @@ func (c *GroupsClient) ListTransitiveMembers(ctx context.Context, id string) (*[
 	return &ret, status, nil
 }
 
+// ListTransitiveMemberEntities retrieves all nested members of the specified Group, optionally queried using OData.
+// id is the object ID of the group.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *GroupsClient) ListTransitiveMemberEntities(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/groups/%s/transitiveMembers", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("GroupsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
 // GetMember retrieves a single member of the specified Group.
 // groupId is the object ID of the group.
 // memberId is the object ID of the member object.
@@ -632,6 +739,37 @@ func (c *GroupsClient) ListOwners(ctx context.Context, id string) (*[]string, in
 	return &ret, status, nil
 }
 
+// ListOwnerEntities retrieves the owners of the specified Group, optionally queried using OData.
+// id is the object ID of the group.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *GroupsClient) ListOwnerEntities(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/groups/%s/owners", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("GroupsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
 // GetOwner retrieves a single owner for the specified Group.
 // groupId is the object ID of the group.
 // ownerId is the object ID of the owning object.
@@ -782,3 +920,124 @@ func (c *GroupsClient) ListAdministrativeUnitMemberships(ctx context.Context, id
 
 	return &data.Members, status, nil
 }
+
+// EvaluateDynamicMembership evaluates whether a user or device is, or would be, a member of a dynamic Group.
+// id is the object ID of the group, memberId is the object ID of the user or device to evaluate.
+func (c *GroupsClient) EvaluateDynamicMembership(ctx context.Context, id, memberId string) (*EvaluateDynamicMembershipResult, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		MemberId string `json:"memberId"`
+	}{
+		MemberId: memberId,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/groups/%s/evaluateDynamicMembership", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("GroupsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var result EvaluateDynamicMembershipResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &result, status, nil
+}
+
+// EvaluateDynamicMembershipRule evaluates a membership rule against a user or device, without the rule having to be
+// saved to a Group. This can be used to validate a rule before creating or updating a dynamic Group.
+// memberId is the object ID of the user or device to evaluate.
+func (c *GroupsClient) EvaluateDynamicMembershipRule(ctx context.Context, memberId, membershipRule string) (*EvaluateDynamicMembershipResult, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		MemberId       string `json:"memberId"`
+		MembershipRule string `json:"membershipRule"`
+	}{
+		MemberId:       memberId,
+		MembershipRule: membershipRule,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/groups/evaluateDynamicMembership",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("GroupsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var result EvaluateDynamicMembershipResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &result, status, nil
+}
+
+// WaitForMembershipRuleProcessing polls a dynamic Group every interval until its membership rule processing status
+// is Succeeded, returning the Group with its latest processing status. An error is returned if processing fails, or
+// if the context is cancelled or reaches its deadline before processing has completed.
+func (c *GroupsClient) WaitForMembershipRuleProcessing(ctx context.Context, id string, interval time.Duration) (*Group, int, error) {
+	if interval <= 0 {
+		return nil, 0, fmt.Errorf("cannot wait for membership rule processing with non-positive interval %s", interval)
+	}
+
+	query := odata.Query{
+		Select: []string{"id", "displayName", "membershipRule", "membershipRuleProcessingState", "membershipRuleProcessingStatus"},
+	}
+
+	for {
+		group, status, err := c.Get(ctx, id, query)
+		if err != nil {
+			return nil, status, err
+		}
+
+		if group.MembershipRuleProcessingStatus != nil && group.MembershipRuleProcessingStatus.Status != nil {
+			switch *group.MembershipRuleProcessingStatus.Status {
+			case MembershipRuleProcessingStatusDetailsSucceeded:
+				return group, status, nil
+			case MembershipRuleProcessingStatusDetailsFailed:
+				var errorMessage string
+				if group.MembershipRuleProcessingStatus.ErrorMessage != nil {
+					errorMessage = *group.MembershipRuleProcessingStatus.ErrorMessage
+				}
+				return group, status, fmt.Errorf("membership rule processing failed for group %q: %s", id, errorMessage)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return group, status, fmt.Errorf("waiting for membership rule processing for group %q: %v", id, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
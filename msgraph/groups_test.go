@@ -1,8 +1,10 @@
 package msgraph_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/manicminer/hamilton/internal/test"
@@ -37,8 +39,10 @@ func TestGroupsClient(t *testing.T) {
 
 	owners := testGroupsClient_ListOwners(t, c, *group.ID())
 	testGroupsClient_GetOwner(t, c, *group.ID(), (*owners)[0])
+	_ = testGroupsClient_ListOwnerEntities(t, c, *group.ID())
 
 	members := testGroupsClient_ListMembers(t, c, *group.ID())
+	_ = testGroupsClient_ListMemberEntities(t, c, *group.ID())
 	listedTransitiveMembers := testGroupsClient_ListTransitiveMembers(t, c, *group.ID())
 	transitiveMembers := testGroupsClient_GetTransitiveMembers(t, c, *group.ID())
 	testGroupsClient_GetMember(t, c, *group.ID(), (*members)[0])
@@ -87,6 +91,43 @@ func TestGroupsClient(t *testing.T) {
 	testGroupsClient_DeletePermanently(t, c, *group365.ID())
 }
 
+func TestGroupsClient_DynamicMembership(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	user := testUsersClient_Create(t, c, msgraph.User{
+		AccountEnabled:    utils.BoolPtr(true),
+		DisplayName:       utils.StringPtr("test-user"),
+		Department:        msgraph.NullableString(msgraph.StringNullWhenEmpty(fmt.Sprintf("test-department-%s", c.RandomString))),
+		MailNickname:      utils.StringPtr(fmt.Sprintf("test-user-%s", c.RandomString)),
+		UserPrincipalName: utils.StringPtr(fmt.Sprintf("test-user-%s@%s", c.RandomString, c.Connections["default"].DomainName)),
+		PasswordProfile: &msgraph.UserPasswordProfile{
+			Password: utils.StringPtr(fmt.Sprintf("IrPa55w0rd%s", c.RandomString)),
+		},
+	})
+
+	membershipRule := fmt.Sprintf(`(user.department -eq "test-department-%s")`, c.RandomString)
+	result := testGroupsClient_EvaluateDynamicMembershipRule(t, c, *user.ID(), membershipRule)
+	if result.MembershipRuleEvaluationResult == nil || !*result.MembershipRuleEvaluationResult {
+		t.Fatal("GroupsClient.EvaluateDynamicMembershipRule(): expected user to match membership rule")
+	}
+
+	group := testGroupsClient_Create(t, c, msgraph.Group{
+		DisplayName:                   utils.StringPtr("test-group-dynamic"),
+		GroupTypes:                    &[]msgraph.GroupType{msgraph.GroupTypeDynamicMembership},
+		MailEnabled:                   utils.BoolPtr(false),
+		MailNickname:                  utils.StringPtr(fmt.Sprintf("test-dynamic-group-%s", c.RandomString)),
+		MembershipRule:                msgraph.NullableString(msgraph.StringNullWhenEmpty(membershipRule)),
+		MembershipRuleProcessingState: utils.StringPtr(msgraph.GroupMembershipRuleProcessingStateOn),
+		SecurityEnabled:               utils.BoolPtr(true),
+	})
+	testGroupsClient_EvaluateDynamicMembership(t, c, *group.ID(), *user.ID())
+	testGroupsClient_WaitForMembershipRuleProcessing(t, c, *group.ID())
+
+	testGroupsClient_Delete(t, c, *group.ID())
+	testUsersClient_Delete(t, c, *user.ID())
+}
+
 func testGroupsClient_Create(t *testing.T, c *test.Test, g msgraph.Group) (group *msgraph.Group) {
 	group, status, err := c.GroupsClient.Create(c.Context, g)
 	if err != nil {
@@ -244,6 +285,43 @@ func testGroupsClient_ListMembers(t *testing.T, c *test.Test, id string) (member
 	return
 }
 
+func testGroupsClient_ListMemberEntities(t *testing.T, c *test.Test, id string) (members *msgraph.DirectoryObjects) {
+	members, status, err := c.GroupsClient.ListMemberEntities(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("GroupsClient.ListMemberEntities(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("GroupsClient.ListMemberEntities(): invalid status: %d", status)
+	}
+	if members == nil {
+		t.Fatal("GroupsClient.ListMemberEntities(): members was nil")
+	}
+	if len(*members) == 0 {
+		t.Fatal("GroupsClient.ListMemberEntities(): members was empty")
+	}
+	if len(members.Unknown()) > 0 {
+		t.Fatal("GroupsClient.ListMemberEntities(): members contained objects of an unknown type")
+	}
+	return
+}
+
+func testGroupsClient_ListOwnerEntities(t *testing.T, c *test.Test, id string) (owners *msgraph.DirectoryObjects) {
+	owners, status, err := c.GroupsClient.ListOwnerEntities(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("GroupsClient.ListOwnerEntities(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("GroupsClient.ListOwnerEntities(): invalid status: %d", status)
+	}
+	if owners == nil {
+		t.Fatal("GroupsClient.ListOwnerEntities(): owners was nil")
+	}
+	if len(*owners) == 0 {
+		t.Fatal("GroupsClient.ListOwnerEntities(): owners was empty")
+	}
+	return
+}
+
 func testGroupsClient_GetTransitiveMembers(t *testing.T, c *test.Test, id string) (members *[]msgraph.User) {
 	members, status, err := c.GroupsClient.GetTransitiveMembers(c.Context, id, odata.Query{})
 	if err != nil {
@@ -388,3 +466,52 @@ func testGroupsClient_RestoreDeleted(t *testing.T, c *test.Test, id string) {
 		t.Fatal("GroupsClient.RestoreDeleted(): group IDs do not match")
 	}
 }
+
+func testGroupsClient_EvaluateDynamicMembership(t *testing.T, c *test.Test, groupId, memberId string) (result *msgraph.EvaluateDynamicMembershipResult) {
+	result, status, err := c.GroupsClient.EvaluateDynamicMembership(c.Context, groupId, memberId)
+	if err != nil {
+		t.Fatalf("GroupsClient.EvaluateDynamicMembership(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("GroupsClient.EvaluateDynamicMembership(): invalid status: %d", status)
+	}
+	if result == nil {
+		t.Fatal("GroupsClient.EvaluateDynamicMembership(): result was nil")
+	}
+	return
+}
+
+func testGroupsClient_EvaluateDynamicMembershipRule(t *testing.T, c *test.Test, memberId, membershipRule string) (result *msgraph.EvaluateDynamicMembershipResult) {
+	result, status, err := c.GroupsClient.EvaluateDynamicMembershipRule(c.Context, memberId, membershipRule)
+	if err != nil {
+		t.Fatalf("GroupsClient.EvaluateDynamicMembershipRule(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("GroupsClient.EvaluateDynamicMembershipRule(): invalid status: %d", status)
+	}
+	if result == nil {
+		t.Fatal("GroupsClient.EvaluateDynamicMembershipRule(): result was nil")
+	}
+	return
+}
+
+func TestGroupsClient_WaitForMembershipRuleProcessingInterval(t *testing.T) {
+	client := msgraph.NewGroupsClient()
+	if _, _, err := client.WaitForMembershipRuleProcessing(context.Background(), "11111111-1111-1111-1111-111111111111", 0); err == nil {
+		t.Fatal("GroupsClient.WaitForMembershipRuleProcessing(): expected error for zero interval")
+	}
+}
+
+func testGroupsClient_WaitForMembershipRuleProcessing(t *testing.T, c *test.Test, id string) (group *msgraph.Group) {
+	group, status, err := c.GroupsClient.WaitForMembershipRuleProcessing(c.Context, id, 10*time.Second)
+	if err != nil {
+		t.Fatalf("GroupsClient.WaitForMembershipRuleProcessing(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("GroupsClient.WaitForMembershipRuleProcessing(): invalid status: %d", status)
+	}
+	if group == nil {
+		t.Fatal("GroupsClient.WaitForMembershipRuleProcessing(): group was nil")
+	}
+	return
+}
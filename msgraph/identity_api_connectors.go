@@ -0,0 +1,242 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+// These authentication configuration types are not yet provided by the odata package
+const (
+	odataTypeBasicAuthentication             odata.Type = "#microsoft.graph.basicAuthentication"
+	odataTypeClientCertificateAuthentication odata.Type = "#microsoft.graph.clientCertificateAuthentication"
+	odataTypePkcs12Certificate               odata.Type = "#microsoft.graph.pkcs12Certificate"
+)
+
+// NewBasicApiAuthenticationConfiguration returns an ApiAuthenticationConfiguration for an API connector using HTTP basic
+// authentication.
+func NewBasicApiAuthenticationConfiguration(username, password string) *ApiAuthenticationConfiguration {
+	return &ApiAuthenticationConfiguration{
+		ODataType: utils.StringPtr(odataTypeBasicAuthentication),
+		Username:  utils.StringPtr(username),
+		Password:  utils.StringPtr(password),
+	}
+}
+
+// NewPkcs12ApiAuthenticationConfiguration returns an ApiAuthenticationConfiguration for an API connector using a client
+// certificate, provided as a PKCS#12 (PFX) archive containing the certificate and private key.
+func NewPkcs12ApiAuthenticationConfiguration(pkcs12 []byte, password string) *ApiAuthenticationConfiguration {
+	return &ApiAuthenticationConfiguration{
+		ODataType:   utils.StringPtr(odataTypePkcs12Certificate),
+		Pkcs12Value: utils.StringPtr(base64.StdEncoding.EncodeToString(pkcs12)),
+		Password:    utils.StringPtr(password),
+	}
+}
+
+// IsClientCertificate returns true when the configuration describes client certificate authentication, as returned by
+// the API after a PKCS#12 certificate has been uploaded.
+func (c ApiAuthenticationConfiguration) IsClientCertificate() bool {
+	return c.ODataType != nil && *c.ODataType == odataTypeClientCertificateAuthentication
+}
+
+// IdentityApiConnectorsClient performs operations on API connectors used in B2C and External Identities user flows.
+type IdentityApiConnectorsClient struct {
+	BaseClient Client
+}
+
+// NewIdentityApiConnectorsClient returns a new IdentityApiConnectorsClient.
+func NewIdentityApiConnectorsClient() *IdentityApiConnectorsClient {
+	return &IdentityApiConnectorsClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// List returns a list of API connectors, optionally queried using OData.
+func (c *IdentityApiConnectorsClient) List(ctx context.Context, query odata.Query) (*[]IdentityApiConnector, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/identity/apiConnectors",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("IdentityApiConnectorsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		ApiConnectors []IdentityApiConnector `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.ApiConnectors, status, nil
+}
+
+// Create creates a new API connector.
+func (c *IdentityApiConnectorsClient) Create(ctx context.Context, apiConnector IdentityApiConnector) (*IdentityApiConnector, int, error) {
+	var status int
+
+	body, err := json.Marshal(apiConnector)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: "/identity/apiConnectors",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("IdentityApiConnectorsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newApiConnector IdentityApiConnector
+	if err := json.Unmarshal(respBody, &newApiConnector); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newApiConnector, status, nil
+}
+
+// Get retrieves an API connector.
+func (c *IdentityApiConnectorsClient) Get(ctx context.Context, id string, query odata.Query) (*IdentityApiConnector, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/apiConnectors/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("IdentityApiConnectorsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var apiConnector IdentityApiConnector
+	if err := json.Unmarshal(respBody, &apiConnector); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &apiConnector, status, nil
+}
+
+// Update amends an existing API connector.
+func (c *IdentityApiConnectorsClient) Update(ctx context.Context, apiConnector IdentityApiConnector) (int, error) {
+	var status int
+
+	if apiConnector.ID == nil {
+		return status, errors.New("cannot update API connector with nil ID")
+	}
+
+	body, err := json.Marshal(apiConnector)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/apiConnectors/%s", *apiConnector.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("IdentityApiConnectorsClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes an API connector.
+func (c *IdentityApiConnectorsClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/apiConnectors/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("IdentityApiConnectorsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// UploadClientCertificate uploads a PKCS#12 (PFX) archive containing a client certificate and private key, to be used
+// by an API connector to authenticate to its API. The previous certificate remains active until the new certificate
+// becomes valid. Returns the updated API connector.
+func (c *IdentityApiConnectorsClient) UploadClientCertificate(ctx context.Context, id string, pkcs12 []byte, password string) (*IdentityApiConnector, int, error) {
+	var status int
+
+	if len(pkcs12) == 0 {
+		return nil, status, errors.New("cannot upload empty PKCS#12 archive")
+	}
+
+	body, err := json.Marshal(struct {
+		Pkcs12Value string `json:"pkcs12Value"`
+		Password    string `json:"password,omitempty"`
+	}{
+		Pkcs12Value: base64.StdEncoding.EncodeToString(pkcs12),
+		Password:    password,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identity/apiConnectors/%s/uploadClientCertificate", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("IdentityApiConnectorsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var apiConnector IdentityApiConnector
+	if err := json.Unmarshal(respBody, &apiConnector); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &apiConnector, status, nil
+}
@@ -0,0 +1,91 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/test"
+	"github.com/manicminer/hamilton/internal/utils"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestIdentityApiConnectorsClient(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	apiConnector := testIdentityApiConnectorsClient_Create(t, c, msgraph.IdentityApiConnector{
+		DisplayName:                 utils.StringPtr(fmt.Sprintf("test-api-connector-%s", c.RandomString)),
+		TargetUrl:                   utils.StringPtr("https://example.com/api/validate"),
+		AuthenticationConfiguration: msgraph.NewBasicApiAuthenticationConfiguration("test-user", fmt.Sprintf("Test-%s", c.RandomString)),
+	})
+	testIdentityApiConnectorsClient_Get(t, c, *apiConnector.ID)
+	testIdentityApiConnectorsClient_Update(t, c, msgraph.IdentityApiConnector{
+		ID:          apiConnector.ID,
+		DisplayName: utils.StringPtr(fmt.Sprintf("test-api-connector-updated-%s", c.RandomString)),
+	})
+	testIdentityApiConnectorsClient_List(t, c)
+	testIdentityApiConnectorsClient_Delete(t, c, *apiConnector.ID)
+}
+
+func testIdentityApiConnectorsClient_Create(t *testing.T, c *test.Test, a msgraph.IdentityApiConnector) *msgraph.IdentityApiConnector {
+	apiConnector, status, err := c.IdentityApiConnectorsClient.Create(c.Context, a)
+	if err != nil {
+		t.Fatalf("IdentityApiConnectorsClient.Create(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("IdentityApiConnectorsClient.Create(): invalid status: %d", status)
+	}
+	if apiConnector == nil {
+		t.Fatal("IdentityApiConnectorsClient.Create(): apiConnector was nil")
+	}
+	if apiConnector.ID == nil {
+		t.Fatal("IdentityApiConnectorsClient.Create(): apiConnector.ID was nil")
+	}
+	return apiConnector
+}
+
+func testIdentityApiConnectorsClient_Get(t *testing.T, c *test.Test, id string) *msgraph.IdentityApiConnector {
+	apiConnector, status, err := c.IdentityApiConnectorsClient.Get(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("IdentityApiConnectorsClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("IdentityApiConnectorsClient.Get(): invalid status: %d", status)
+	}
+	if apiConnector == nil {
+		t.Fatal("IdentityApiConnectorsClient.Get(): apiConnector was nil")
+	}
+	return apiConnector
+}
+
+func testIdentityApiConnectorsClient_Update(t *testing.T, c *test.Test, a msgraph.IdentityApiConnector) {
+	status, err := c.IdentityApiConnectorsClient.Update(c.Context, a)
+	if err != nil {
+		t.Fatalf("IdentityApiConnectorsClient.Update(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("IdentityApiConnectorsClient.Update(): invalid status: %d", status)
+	}
+}
+
+func testIdentityApiConnectorsClient_List(t *testing.T, c *test.Test) *[]msgraph.IdentityApiConnector {
+	apiConnectors, _, err := c.IdentityApiConnectorsClient.List(c.Context, odata.Query{Top: 10})
+	if err != nil {
+		t.Fatalf("IdentityApiConnectorsClient.List(): %v", err)
+	}
+	if apiConnectors == nil {
+		t.Fatal("IdentityApiConnectorsClient.List(): apiConnectors was nil")
+	}
+	return apiConnectors
+}
+
+func testIdentityApiConnectorsClient_Delete(t *testing.T, c *test.Test, id string) {
+	status, err := c.IdentityApiConnectorsClient.Delete(c.Context, id)
+	if err != nil {
+		t.Fatalf("IdentityApiConnectorsClient.Delete(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("IdentityApiConnectorsClient.Delete(): invalid status: %d", status)
+	}
+}
@@ -3,9 +3,13 @@ package msgraph
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
 )
 
 // InvitationsClient performs operations on Invitations.
@@ -53,3 +57,90 @@ func (c *InvitationsClient) Create(ctx context.Context, invitation Invitation) (
 
 	return &newInvitation, status, nil
 }
+
+// Resend sends a new invitation message to an existing guest user who has not yet redeemed their invitation. The
+// InvitedUserEmailAddress and InviteRedirectURL fields of the invitation must be specified.
+func (c *InvitationsClient) Resend(ctx context.Context, userId string, invitation Invitation) (*Invitation, int, error) {
+	if invitation.InvitedUserEmailAddress == nil || invitation.InviteRedirectURL == nil {
+		return nil, 0, errors.New("cannot resend invitation with nil InvitedUserEmailAddress or InviteRedirectURL")
+	}
+
+	invitation.InvitedUser = &User{DirectoryObject: DirectoryObject{Id: &userId}}
+	invitation.SendInvitationMessage = utils.BoolPtr(true)
+
+	return c.Create(ctx, invitation)
+}
+
+// ResetRedemption resets the redemption status of an existing guest user, so that they must redeem a new invitation
+// before signing in. This allows a guest user to sign in with a different identity, for example after their email
+// address has changed. The InvitedUserEmailAddress and InviteRedirectURL fields of the invitation must be specified,
+// and an invitation message is only sent when SendInvitationMessage is true.
+func (c *InvitationsClient) ResetRedemption(ctx context.Context, userId string, invitation Invitation) (*Invitation, int, error) {
+	if invitation.InvitedUserEmailAddress == nil || invitation.InviteRedirectURL == nil {
+		return nil, 0, errors.New("cannot reset redemption with nil InvitedUserEmailAddress or InviteRedirectURL")
+	}
+
+	invitation.InvitedUser = &User{DirectoryObject: DirectoryObject{Id: &userId}}
+	invitation.ResetRedemption = utils.BoolPtr(true)
+
+	return c.Create(ctx, invitation)
+}
+
+// ListGuests returns a list of guest users, along with their invitation redemption status. When externalUserState is
+// not empty, only guests having the specified state are returned. The query can be used to further filter the results.
+// When the query does not select any properties, the default user properties are returned along with the redemption
+// status.
+func (c *InvitationsClient) ListGuests(ctx context.Context, externalUserState ExternalUserState, query odata.Query) (*[]User, int, error) {
+	filter := "userType eq 'Guest'"
+	if externalUserState != "" {
+		filter = fmt.Sprintf("%s and externalUserState eq '%s'", filter, odata.EscapeSingleQuote(externalUserState))
+	}
+	if query.Filter != "" {
+		filter = fmt.Sprintf("%s and (%s)", filter, query.Filter)
+	}
+	query.Filter = filter
+	query.ConsistencyLevel = odata.ConsistencyLevelEventual
+	query.Count = true
+
+	// the redemption status is not returned by default, so always select it alongside the requested properties
+	base := query.Select
+	if len(base) == 0 {
+		base = defaultUserProperties
+	}
+	sel := make([]string, 0, len(base)+2)
+	seen := make(map[string]bool)
+	for _, s := range append(append([]string{}, base...), "externalUserState", "externalUserStateChangeDateTime") {
+		if !seen[s] {
+			seen[s] = true
+			sel = append(sel, s)
+		}
+	}
+	query.Select = sel
+
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/users",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("InvitationsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Users []User `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Users, status, nil
+}
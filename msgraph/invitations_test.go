@@ -1,9 +1,13 @@
 package msgraph_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/manicminer/hamilton/internal/test"
 	"github.com/manicminer/hamilton/internal/utils"
 	"github.com/manicminer/hamilton/msgraph"
@@ -13,11 +17,26 @@ func TestInvitationsClient(t *testing.T) {
 	c := test.NewTest(t)
 	defer c.CancelFunc()
 
-	testInvitationsClient_Create(t, c, msgraph.Invitation{
+	invitation := testInvitationsClient_Create(t, c, msgraph.Invitation{
 		InvitedUserDisplayName:  utils.StringPtr("test-user-invited"),
 		InvitedUserEmailAddress: utils.StringPtr(fmt.Sprintf("test-user-%s@test.com", c.RandomString)),
 		InviteRedirectURL:       utils.StringPtr(fmt.Sprintf("https://myapp-%s.contoso.com", c.RandomString)),
 	})
+	if invitation.InvitedUser == nil || invitation.InvitedUser.ID() == nil {
+		t.Fatal("InvitationsClient.Create(): invitation.InvitedUser.ID was nil")
+	}
+	userId := *invitation.InvitedUser.ID()
+
+	testInvitationsClient_ListGuests(t, c, msgraph.ExternalUserStatePendingAcceptance)
+	testInvitationsClient_Resend(t, c, userId, msgraph.Invitation{
+		InvitedUserEmailAddress: invitation.InvitedUserEmailAddress,
+		InviteRedirectURL:       invitation.InviteRedirectURL,
+	})
+	testInvitationsClient_ResetRedemption(t, c, userId, msgraph.Invitation{
+		InvitedUserEmailAddress: invitation.InvitedUserEmailAddress,
+		InviteRedirectURL:       invitation.InviteRedirectURL,
+	})
+	testUsersClient_Delete(t, c, userId)
 }
 
 func testInvitationsClient_Create(t *testing.T, c *test.Test, i msgraph.Invitation) (invitation *msgraph.Invitation) {
@@ -36,3 +55,92 @@ func testInvitationsClient_Create(t *testing.T, c *test.Test, i msgraph.Invitati
 	}
 	return
 }
+
+func testInvitationsClient_Resend(t *testing.T, c *test.Test, userId string, i msgraph.Invitation) (invitation *msgraph.Invitation) {
+	invitation, status, err := c.InvitationsClient.Resend(c.Context, userId, i)
+	if err != nil {
+		t.Fatalf("InvitationsClient.Resend(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("InvitationsClient.Resend(): invalid status: %d", status)
+	}
+	if invitation == nil {
+		t.Fatal("InvitationsClient.Resend(): invitation was nil")
+	}
+	return
+}
+
+func testInvitationsClient_ResetRedemption(t *testing.T, c *test.Test, userId string, i msgraph.Invitation) (invitation *msgraph.Invitation) {
+	invitation, status, err := c.InvitationsClient.ResetRedemption(c.Context, userId, i)
+	if err != nil {
+		t.Fatalf("InvitationsClient.ResetRedemption(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("InvitationsClient.ResetRedemption(): invalid status: %d", status)
+	}
+	if invitation == nil {
+		t.Fatal("InvitationsClient.ResetRedemption(): invitation was nil")
+	}
+	return
+}
+
+func testInvitationsClient_ListGuests(t *testing.T, c *test.Test, externalUserState msgraph.ExternalUserState) (users *[]msgraph.User) {
+	users, _, err := c.InvitationsClient.ListGuests(c.Context, externalUserState, odata.Query{Top: 10})
+	if err != nil {
+		t.Fatalf("InvitationsClient.ListGuests(): %v", err)
+	}
+	if users == nil {
+		t.Fatal("InvitationsClient.ListGuests(): users was nil")
+	}
+	return
+}
+
+func TestInvitationsClient_ListGuestsQuery(t *testing.T) {
+	expectedFilter := "userType eq 'Guest' and externalUserState eq 'Pending''Acceptance' and (accountEnabled eq true)"
+	expectedSelect := "displayName,mail,externalUserState,externalUserStateChangeDateTime"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/beta/users" {
+			t.Errorf("unexpected request path %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if filter := r.URL.Query().Get("$filter"); filter != expectedFilter {
+			t.Errorf("unexpected $filter %q", filter)
+		}
+		if sel := r.URL.Query().Get("$select"); sel != expectedSelect {
+			t.Errorf("unexpected $select %q", sel)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"value":[{"id":"11111111-1111-1111-1111-111111111111","externalUserState":"PendingAcceptance"}]}`))
+	}))
+	defer ts.Close()
+
+	client := msgraph.NewInvitationsClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	sel := make([]string, 2, 4)
+	copy(sel, []string{"displayName", "mail"})
+	users, _, err := client.ListGuests(context.Background(), "Pending'Acceptance", odata.Query{Filter: "accountEnabled eq true", Select: sel})
+	if err != nil {
+		t.Fatalf("InvitationsClient.ListGuests(): %v", err)
+	}
+	if users == nil || len(*users) != 1 {
+		t.Fatalf("InvitationsClient.ListGuests(): unexpected users: %v", users)
+	}
+	if extra := sel[:cap(sel)][2:]; extra[0] != "" || extra[1] != "" {
+		t.Fatalf("InvitationsClient.ListGuests(): caller's Select backing array was modified: %v", extra)
+	}
+
+	// the redemption status should be selected along with the default user properties when nothing is selected
+	expectedFilter = "userType eq 'Guest'"
+	expectedSelect = "businessPhones,displayName,givenName,id,jobTitle,mail,mobilePhone,officeLocation,preferredLanguage,surname,userPrincipalName,externalUserState,externalUserStateChangeDateTime"
+	users, _, err = client.ListGuests(context.Background(), "", odata.Query{})
+	if err != nil {
+		t.Fatalf("InvitationsClient.ListGuests(): %v", err)
+	}
+	if users == nil || len(*users) != 1 || (*users)[0].ExternalUserState == nil {
+		t.Fatalf("InvitationsClient.ListGuests(): unexpected users: %v", users)
+	}
+}
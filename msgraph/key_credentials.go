@@ -0,0 +1,267 @@
+package msgraph
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manicminer/hamilton/internal/utils"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// proofOfPossessionAudience is the audience required in proof-of-possession tokens, which is the application ID of the
+// legacy Azure AD Graph API.
+const proofOfPossessionAudience = "00000002-0000-0000-c000-000000000000"
+
+// proofOfPossessionLifetime is the validity period of generated proof-of-possession tokens. The API rejects tokens
+// valid for longer than 10 minutes.
+const proofOfPossessionLifetime = 10 * time.Minute
+
+// ProofOfPossessionSigner holds an existing certificate credential, and its private key, for an application or service
+// principal. It is used to sign the proof-of-possession tokens required when calling AddKey() or RemoveKey(), which
+// allows an application to manage its own certificates without requiring any additional API permissions.
+type ProofOfPossessionSigner struct {
+	Certificate *x509.Certificate
+	Signer      crypto.Signer
+}
+
+// NewProofOfPossessionSignerFromPkcs12 returns a ProofOfPossessionSigner for the certificate and private key contained
+// in a PKCS#12 (PFX) archive.
+func NewProofOfPossessionSignerFromPkcs12(pfx []byte, password string) (*ProofOfPossessionSigner, error) {
+	key, certificate, _, err := pkcs12.DecodeChain(pfx, password)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs12.DecodeChain(): %v", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key in PKCS#12 archive cannot be used for signing")
+	}
+
+	return &ProofOfPossessionSigner{
+		Certificate: certificate,
+		Signer:      signer,
+	}, nil
+}
+
+// NewProofOfPossessionSignerFromPem returns a ProofOfPossessionSigner for PEM encoded data containing both a
+// certificate and an unencrypted PKCS#1 or PKCS#8 private key.
+func NewProofOfPossessionSignerFromPem(data []byte) (*ProofOfPossessionSigner, error) {
+	var ret ProofOfPossessionSigner
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "CERTIFICATE":
+			if ret.Certificate != nil {
+				continue
+			}
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("x509.ParseCertificate(): %v", err)
+			}
+			ret.Certificate = certificate
+
+		case "RSA PRIVATE KEY":
+			key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("x509.ParsePKCS1PrivateKey(): %v", err)
+			}
+			ret.Signer = key
+
+		case "PRIVATE KEY":
+			key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("x509.ParsePKCS8PrivateKey(): %v", err)
+			}
+			signer, ok := key.(crypto.Signer)
+			if !ok {
+				return nil, errors.New("private key cannot be used for signing")
+			}
+			ret.Signer = signer
+		}
+	}
+
+	if ret.Certificate == nil {
+		return nil, errors.New("no certificate found in PEM data")
+	}
+	if ret.Signer == nil {
+		return nil, errors.New("no private key found in PEM data")
+	}
+
+	return &ret, nil
+}
+
+// Token returns a signed proof-of-possession token for the application or service principal with the specified
+// object ID.
+func (s ProofOfPossessionSigner) Token(objectId string) (string, error) {
+	if s.Certificate == nil {
+		return "", errors.New("cannot sign proof-of-possession token with nil Certificate")
+	}
+	if s.Signer == nil {
+		return "", errors.New("cannot sign proof-of-possession token with nil Signer")
+	}
+	if _, ok := s.Signer.Public().(*rsa.PublicKey); !ok {
+		return "", errors.New("proof-of-possession tokens can only be signed with an RSA private key")
+	}
+
+	thumbprint := sha1.Sum(s.Certificate.Raw)
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"x5t": base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	})
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	now := time.Now()
+	claims, err := json.Marshal(map[string]interface{}{
+		"aud": proofOfPossessionAudience,
+		"iss": objectId,
+		"nbf": now.Unix(),
+		"exp": now.Add(proofOfPossessionLifetime).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := s.Signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", fmt.Errorf("signing proof-of-possession token: %v", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// NewKeyCredentialFromCertificate returns a KeyCredential for the specified certificate, suitable for use with AddKey().
+func NewKeyCredentialFromCertificate(certificate *x509.Certificate) KeyCredential {
+	return KeyCredential{
+		Key:   utils.StringPtr(base64.StdEncoding.EncodeToString(certificate.Raw)),
+		Type:  KeyCredentialTypeAsymmetricX509Cert,
+		Usage: KeyCredentialUsageVerify,
+	}
+}
+
+// NewKeyCredentialFromPem returns a KeyCredential for the first certificate found in PEM encoded data. Any private key
+// in the data is ignored.
+func NewKeyCredentialFromPem(data []byte) (*KeyCredential, error) {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no certificate found in PEM data")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("x509.ParseCertificate(): %v", err)
+		}
+		keyCredential := NewKeyCredentialFromCertificate(certificate)
+		return &keyCredential, nil
+	}
+}
+
+// NewKeyCredentialFromPkcs12 returns a KeyCredential for the certificate contained in a PKCS#12 (PFX) archive. The
+// private key is not uploaded.
+func NewKeyCredentialFromPkcs12(pfx []byte, password string) (*KeyCredential, error) {
+	_, certificate, _, err := pkcs12.DecodeChain(pfx, password)
+	if err != nil {
+		return nil, fmt.Errorf("pkcs12.DecodeChain(): %v", err)
+	}
+	keyCredential := NewKeyCredentialFromCertificate(certificate)
+	return &keyCredential, nil
+}
+
+// findKeyCredentialForCertificate returns the KeyCredential matching the thumbprint of the specified certificate.
+// Returns nil if no match was found.
+func findKeyCredentialForCertificate(keyCredentials *[]KeyCredential, certificate *x509.Certificate) *KeyCredential {
+	if keyCredentials == nil || certificate == nil {
+		return nil
+	}
+
+	thumbprint := sha1.Sum(certificate.Raw)
+	for _, keyCredential := range *keyCredentials {
+		if strings.EqualFold(keyCredentialThumbprint(keyCredential), hex.EncodeToString(thumbprint[:])) {
+			return &keyCredential
+		}
+	}
+
+	return nil
+}
+
+// KeyRotation describes a certificate rotation started with BeginKeyRotation(). Until the rotation is completed with
+// CompleteKeyRotation(), both the previous and the new certificate are valid, allowing consumers of the certificate to
+// be updated without downtime.
+type KeyRotation struct {
+	// PreviousKeyId is the key ID of the certificate credential being replaced
+	PreviousKeyId string
+
+	// NewKeyCredential is the certificate credential which was added
+	NewKeyCredential *KeyCredential
+}
+
+// beginKeyRotation starts a certificate rotation by adding the new certificate using a proof signed by the current
+// certificate. The current certificate remains valid until the rotation is completed with completeKeyRotation.
+func beginKeyRotation(currentKeyId string, current, next ProofOfPossessionSigner,
+	listKeys func() (*[]KeyCredential, int, error),
+	addKey func(KeyCredential, ProofOfPossessionSigner) (*KeyCredential, int, error)) (*KeyRotation, int, error) {
+	if next.Certificate == nil {
+		return nil, 0, errors.New("cannot rotate to nil Certificate")
+	}
+
+	if currentKeyId == "" {
+		keyCredentials, status, err := listKeys()
+		if err != nil {
+			return nil, status, err
+		}
+		keyCredential := findKeyCredentialForCertificate(keyCredentials, current.Certificate)
+		if keyCredential == nil || keyCredential.KeyId == nil {
+			return nil, status, errors.New("could not find key credential matching the current certificate")
+		}
+		currentKeyId = *keyCredential.KeyId
+	}
+
+	newKeyCredential, status, err := addKey(NewKeyCredentialFromCertificate(next.Certificate), current)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &KeyRotation{
+		PreviousKeyId:    currentKeyId,
+		NewKeyCredential: newKeyCredential,
+	}, status, nil
+}
+
+// completeKeyRotation completes a certificate rotation by removing the previous certificate using a proof signed by
+// the new certificate, which also verifies that the new certificate is usable before the previous one is removed.
+func completeKeyRotation(rotation KeyRotation, next ProofOfPossessionSigner, removeKey func(string, ProofOfPossessionSigner) (int, error)) (int, error) {
+	if rotation.PreviousKeyId == "" {
+		return 0, errors.New("cannot complete key rotation with empty PreviousKeyId")
+	}
+	if next.Certificate == nil {
+		return 0, errors.New("cannot complete key rotation with nil Certificate")
+	}
+
+	return removeKey(rotation.PreviousKeyId, next)
+}
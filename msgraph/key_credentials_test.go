@@ -0,0 +1,175 @@
+package msgraph
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+func testKeyCredentialsCertificate(t *testing.T) ProofOfPossessionSigner {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey(): %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "hamilton-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate(): %v", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate(): %v", err)
+	}
+	return ProofOfPossessionSigner{Certificate: certificate, Signer: key}
+}
+
+func TestProofOfPossessionSigner_Token(t *testing.T) {
+	signer := testKeyCredentialsCertificate(t)
+
+	token, err := signer.Token("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		t.Fatalf("ProofOfPossessionSigner.Token(): %v", err)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("ProofOfPossessionSigner.Token(): expected 3 token segments, got %d", len(parts))
+	}
+
+	headerJson, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	var header map[string]string
+	if err := json.Unmarshal(headerJson, &header); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	thumbprint := sha1.Sum(signer.Certificate.Raw)
+	if header["alg"] != "RS256" || header["x5t"] != base64.RawURLEncoding.EncodeToString(thumbprint[:]) {
+		t.Errorf("ProofOfPossessionSigner.Token(): unexpected header: %v", header)
+	}
+
+	claimsJson, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	var claims struct {
+		Aud string `json:"aud"`
+		Iss string `json:"iss"`
+		Nbf int64  `json:"nbf"`
+		Exp int64  `json:"exp"`
+	}
+	if err := json.Unmarshal(claimsJson, &claims); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if claims.Aud != proofOfPossessionAudience || claims.Iss != "11111111-1111-1111-1111-111111111111" {
+		t.Errorf("ProofOfPossessionSigner.Token(): unexpected claims: %+v", claims)
+	}
+	if lifetime := claims.Exp - claims.Nbf; lifetime <= 0 || lifetime > 600 {
+		t.Errorf("ProofOfPossessionSigner.Token(): unexpected token lifetime: %ds", lifetime)
+	}
+
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(signer.Certificate.PublicKey.(*rsa.PublicKey), crypto.SHA256, digest[:], signature); err != nil {
+		t.Errorf("ProofOfPossessionSigner.Token(): invalid signature: %v", err)
+	}
+}
+
+func TestNewProofOfPossessionSignerFromPem(t *testing.T) {
+	signer := testKeyCredentialsCertificate(t)
+
+	keyDer, err := x509.MarshalPKCS8PrivateKey(signer.Signer)
+	if err != nil {
+		t.Fatalf("x509.MarshalPKCS8PrivateKey(): %v", err)
+	}
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})
+	data := append(keyPem, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: signer.Certificate.Raw})...)
+
+	parsed, err := NewProofOfPossessionSignerFromPem(data)
+	if err != nil {
+		t.Fatalf("NewProofOfPossessionSignerFromPem(): %v", err)
+	}
+	if !parsed.Certificate.Equal(signer.Certificate) {
+		t.Error("NewProofOfPossessionSignerFromPem(): certificate did not match")
+	}
+
+	keyCredential, err := NewKeyCredentialFromPem(data)
+	if err != nil {
+		t.Fatalf("NewKeyCredentialFromPem(): %v", err)
+	}
+	if keyCredential.Key == nil || *keyCredential.Key != base64.StdEncoding.EncodeToString(signer.Certificate.Raw) {
+		t.Error("NewKeyCredentialFromPem(): unexpected Key")
+	}
+
+	if _, err := NewProofOfPossessionSignerFromPem(keyPem); err == nil {
+		t.Error("NewProofOfPossessionSignerFromPem(): expected error for PEM data without certificate")
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	current := testKeyCredentialsCertificate(t)
+	next := testKeyCredentialsCertificate(t)
+
+	thumbprint := sha1.Sum(current.Certificate.Raw)
+	keyCredentials := []KeyCredential{
+		{KeyId: utils.StringPtr("00000000-0000-0000-0000-000000000000"), CustomKeyIdentifier: utils.StringPtr("b3RoZXI=")},
+		{KeyId: utils.StringPtr("11111111-1111-1111-1111-111111111111"), CustomKeyIdentifier: utils.StringPtr(base64.StdEncoding.EncodeToString(thumbprint[:]))},
+	}
+
+	rotation, _, err := beginKeyRotation("", current, next,
+		func() (*[]KeyCredential, int, error) {
+			return &keyCredentials, 200, nil
+		},
+		func(keyCredential KeyCredential, proof ProofOfPossessionSigner) (*KeyCredential, int, error) {
+			if proof.Certificate != current.Certificate {
+				t.Error("beginKeyRotation(): new key was not added using the current certificate")
+			}
+			keyCredential.KeyId = utils.StringPtr("22222222-2222-2222-2222-222222222222")
+			return &keyCredential, 200, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("beginKeyRotation(): %v", err)
+	}
+	if rotation.PreviousKeyId != "11111111-1111-1111-1111-111111111111" {
+		t.Errorf("beginKeyRotation(): unexpected previous key %q", rotation.PreviousKeyId)
+	}
+	if rotation.NewKeyCredential == nil || rotation.NewKeyCredential.KeyId == nil || *rotation.NewKeyCredential.KeyId != "22222222-2222-2222-2222-222222222222" {
+		t.Errorf("beginKeyRotation(): unexpected new key credential: %+v", rotation.NewKeyCredential)
+	}
+
+	var removed bool
+	_, err = completeKeyRotation(*rotation, next, func(keyId string, proof ProofOfPossessionSigner) (int, error) {
+		if proof.Certificate != next.Certificate {
+			t.Error("completeKeyRotation(): previous key was not removed using the new certificate")
+		}
+		if keyId != "11111111-1111-1111-1111-111111111111" {
+			t.Errorf("completeKeyRotation(): removed unexpected key %q", keyId)
+		}
+		removed = true
+		return 204, nil
+	})
+	if err != nil {
+		t.Fatalf("completeKeyRotation(): %v", err)
+	}
+	if !removed {
+		t.Error("completeKeyRotation(): previous key was not removed")
+	}
+
+	if _, err = completeKeyRotation(KeyRotation{}, next, nil); err == nil {
+		t.Error("completeKeyRotation(): expected error for empty PreviousKeyId")
+	}
+}
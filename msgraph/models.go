@@ -5,6 +5,7 @@ import (
 	goerrors "errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
@@ -216,10 +217,40 @@ type AddInKeyValue struct {
 }
 
 type AdministrativeUnit struct {
-	Description *StringNullWhenEmpty          `json:"description,omitempty"`
-	DisplayName *string                       `json:"displayName,omitempty"`
-	ID          *string                       `json:"id,omitempty"`
-	Visibility  *AdministrativeUnitVisibility `json:"visibility,omitempty"`
+	Description                    *StringNullWhenEmpty                             `json:"description,omitempty"`
+	DisplayName                    *string                                          `json:"displayName,omitempty"`
+	ID                             *string                                          `json:"id,omitempty"`
+	MembershipRule                 *StringNullWhenEmpty                             `json:"membershipRule,omitempty"`
+	MembershipRuleProcessingState  *AdministrativeUnitMembershipRuleProcessingState `json:"membershipRuleProcessingState,omitempty"`
+	MembershipRuleProcessingStatus *MembershipRuleProcessingStatus                  `json:"membershipRuleProcessingStatus,omitempty"`
+	MembershipType                 *AdministrativeUnitMembershipType                `json:"membershipType,omitempty"`
+	Visibility                     *AdministrativeUnitVisibility                    `json:"visibility,omitempty"`
+}
+
+// AdministrativeUnitEntity represents an AdministrativeUnit in a DirectoryObjects collection. AdministrativeUnit exposes
+// its object ID as a field, so it is wrapped here to satisfy DirectoryObjectEntity.
+type AdministrativeUnitEntity struct {
+	AdministrativeUnit
+}
+
+func (a *AdministrativeUnitEntity) ID() *string {
+	return a.AdministrativeUnit.ID
+}
+
+type AlternativeSecurityId struct {
+	IdentityProvider *string `json:"identityProvider,omitempty"`
+	Key              *string `json:"key,omitempty"`
+	Type             *int    `json:"type,omitempty"`
+}
+
+// ApiAuthenticationConfiguration describes how an API connector authenticates to an API. Set ODataType to indicate
+// whether basic authentication or a PKCS#12 client certificate is used.
+type ApiAuthenticationConfiguration struct {
+	ODataType       *odata.Type                     `json:"@odata.type,omitempty"`
+	CertificateList *[]Pkcs12CertificateInformation `json:"certificateList,omitempty"`
+	Password        *string                         `json:"password,omitempty"`
+	Pkcs12Value     *string                         `json:"pkcs12Value,omitempty"`
+	Username        *string                         `json:"username,omitempty"`
 }
 
 type ApiPreAuthorizedApplication struct {
@@ -234,6 +265,23 @@ type AppIdentity struct {
 	ServicePrincipalName *string `json:"servicePrincipalName,omitempty"`
 }
 
+// AppCredentialSignInActivity describes the most recent sign-in activity for a credential of an application or service
+// principal.
+type AppCredentialSignInActivity struct {
+	Id                       *string                `json:"id,omitempty"`
+	AppId                    *string                `json:"appId,omitempty"`
+	AppObjectId              *string                `json:"appObjectId,omitempty"`
+	CreatedDateTime          *time.Time             `json:"createdDateTime,omitempty"`
+	CredentialOrigin         *AppCredentialOrigin   `json:"credentialOrigin,omitempty"`
+	ExpirationDateTime       *time.Time             `json:"expirationDateTime,omitempty"`
+	KeyId                    *string                `json:"keyId,omitempty"`
+	KeyType                  *AppCredentialKeyType  `json:"keyType,omitempty"`
+	KeyUsage                 *AppCredentialKeyUsage `json:"keyUsage,omitempty"`
+	ResourceId               *string                `json:"resourceId,omitempty"`
+	ServicePrincipalObjectId *string                `json:"servicePrincipalObjectId,omitempty"`
+	SignInActivity           *SignInActivity        `json:"signInActivity,omitempty"`
+}
+
 // Application describes an Application object.
 type Application struct {
 	DirectoryObject
@@ -268,6 +316,7 @@ type Application struct {
 	PublicClient                  *PublicClient             `json:"publicClient,omitempty"`
 	PublisherDomain               *string                   `json:"publisherDomain,omitempty"`
 	RequiredResourceAccess        *[]RequiredResourceAccess `json:"requiredResourceAccess,omitempty"`
+	SamlMetadataUrl               *StringNullWhenEmpty      `json:"samlMetadataUrl,omitempty"`
 	ServiceManagementReference    *StringNullWhenEmpty      `json:"serviceManagementReference,omitempty"`
 	SignInAudience                *SignInAudience           `json:"signInAudience,omitempty"`
 	Spa                           *ApplicationSpa           `json:"spa,omitempty"`
@@ -489,6 +538,24 @@ type ApplicationExtension struct {
 	TargetObjects          *[]ApplicationExtensionTargetObject `json:"targetObjects,omitempty"`
 }
 
+// ApplicationSegment describes an application segment published through application proxy. Web application segments
+// use AlternateUrl, CorsConfigurations, ExternalUrl and InternalUrl, whereas IP application segments use DestinationHost,
+// DestinationType, Ports and Protocol.
+type ApplicationSegment struct {
+	ODataType *odata.Type `json:"@odata.type,omitempty"`
+	ID        *string     `json:"id,omitempty"`
+
+	AlternateUrl       *string              `json:"alternateUrl,omitempty"`
+	CorsConfigurations *[]CorsConfiguration `json:"corsConfigurations,omitempty"`
+	ExternalUrl        *string              `json:"externalUrl,omitempty"`
+	InternalUrl        *string              `json:"internalUrl,omitempty"`
+
+	DestinationHost *string                        `json:"destinationHost,omitempty"`
+	DestinationType *PrivateNetworkDestinationType `json:"destinationType,omitempty"`
+	Ports           *[]string                      `json:"ports,omitempty"`
+	Protocol        *PrivateNetworkProtocol        `json:"protocol,omitempty"`
+}
+
 type ApplicationSpa struct {
 	RedirectUris *[]string `json:"redirectUris,omitempty"`
 }
@@ -592,6 +659,88 @@ type AssignmentReviewSettings struct {
 	AccessReviewTimeoutBehavior     AccessReviewTimeoutBehaviorType `json:"accessReviewTimeoutBehavior,omitempty"`
 }
 
+type AttributeDefinition struct {
+	Anchor            *bool                           `json:"anchor,omitempty"`
+	ApiExpressions    *[]SynchronizationMetadataEntry `json:"apiExpressions,omitempty"`
+	CaseExact         *bool                           `json:"caseExact,omitempty"`
+	DefaultValue      *string                         `json:"defaultValue,omitempty"`
+	FlowNullValues    *bool                           `json:"flowNullValues,omitempty"`
+	Metadata          *[]SynchronizationMetadataEntry `json:"metadata,omitempty"`
+	Multivalued       *bool                           `json:"multivalued,omitempty"`
+	Mutability        *Mutability                     `json:"mutability,omitempty"`
+	Name              *string                         `json:"name,omitempty"`
+	ReferencedObjects *[]ReferencedObject             `json:"referencedObjects,omitempty"`
+	Required          *bool                           `json:"required,omitempty"`
+	Type              *AttributeType                  `json:"type,omitempty"`
+}
+
+type AttributeMapping struct {
+	DefaultValue            *string                 `json:"defaultValue,omitempty"`
+	ExportMissingReferences *bool                   `json:"exportMissingReferences,omitempty"`
+	FlowBehavior            *AttributeFlowBehavior  `json:"flowBehavior,omitempty"`
+	FlowType                *AttributeFlowType      `json:"flowType,omitempty"`
+	MatchingPriority        *int                    `json:"matchingPriority,omitempty"`
+	Source                  *AttributeMappingSource `json:"source,omitempty"`
+	TargetAttributeName     *string                 `json:"targetAttributeName,omitempty"`
+}
+
+// NewDirectAttributeMapping returns an AttributeMapping that flows the value of a source attribute to a target attribute.
+func NewDirectAttributeMapping(sourceAttributeName, targetAttributeName string) AttributeMapping {
+	return AttributeMapping{
+		FlowBehavior: utils.StringPtr(AttributeFlowBehaviorFlowWhenChanged),
+		FlowType:     utils.StringPtr(AttributeFlowTypeAlways),
+		Source: &AttributeMappingSource{
+			Name: utils.StringPtr(sourceAttributeName),
+			Type: utils.StringPtr(AttributeMappingSourceTypeAttribute),
+		},
+		TargetAttributeName: utils.StringPtr(targetAttributeName),
+	}
+}
+
+// NewConstantAttributeMapping returns an AttributeMapping that sets a target attribute to a constant value.
+func NewConstantAttributeMapping(value, targetAttributeName string) AttributeMapping {
+	return AttributeMapping{
+		FlowBehavior: utils.StringPtr(AttributeFlowBehaviorFlowWhenChanged),
+		FlowType:     utils.StringPtr(AttributeFlowTypeAlways),
+		Source: &AttributeMappingSource{
+			Expression: utils.StringPtr(fmt.Sprintf("%q", value)),
+			Name:       utils.StringPtr(value),
+			Type:       utils.StringPtr(AttributeMappingSourceTypeConstant),
+		},
+		TargetAttributeName: utils.StringPtr(targetAttributeName),
+	}
+}
+
+// NewExpressionAttributeMapping returns an AttributeMapping that sets a target attribute using an expression. The
+// source should be the ParsedExpression returned by SynchronizationJobClient.ParseExpression().
+func NewExpressionAttributeMapping(source AttributeMappingSource, targetAttributeName string) AttributeMapping {
+	return AttributeMapping{
+		FlowBehavior:        utils.StringPtr(AttributeFlowBehaviorFlowWhenChanged),
+		FlowType:            utils.StringPtr(AttributeFlowTypeAlways),
+		Source:              &source,
+		TargetAttributeName: utils.StringPtr(targetAttributeName),
+	}
+}
+
+type AttributeMappingFunctionSchema struct {
+	Name       *string                            `json:"name,omitempty"`
+	Parameters *[]AttributeMappingParameterSchema `json:"parameters,omitempty"`
+}
+
+type AttributeMappingParameterSchema struct {
+	AllowMultipleOccurrences *bool          `json:"allowMultipleOccurrences,omitempty"`
+	Name                     *string        `json:"name,omitempty"`
+	Required                 *bool          `json:"required,omitempty"`
+	Type                     *AttributeType `json:"type,omitempty"`
+}
+
+type AttributeMappingSource struct {
+	Expression *string                                     `json:"expression,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Parameters *[]StringKeyAttributeMappingSourceValuePair `json:"parameters,omitempty"`
+	Type       *AttributeMappingSourceType                 `json:"type,omitempty"`
+}
+
 type AuditActivityInitiator struct {
 	App  *AppIdentity  `json:"app,omitempty"`
 	User *UserIdentity `json:"user,omitempty"`
@@ -609,6 +758,73 @@ type AuthenticationStrengthPolicy struct {
 	DisplayName         *string                           `json:"displayName,omitempty"`
 }
 
+// B2BManagementPolicy describes the B2B collaboration settings for a tenant, including the domains to which
+// invitations can be sent. The policy settings are contained in Definition as a JSON document, use the
+// DomainsPolicy() and SetDomainsPolicy() methods to work with the invitation domain allow and deny lists.
+type B2BManagementPolicy struct {
+	Definition  *[]string `json:"definition,omitempty"`
+	DisplayName *string   `json:"displayName,omitempty"`
+	ID          *string   `json:"id,omitempty"`
+}
+
+// DomainsPolicy returns the invitation domain allow and deny lists from the policy definition.
+// Returns nil when the policy does not restrict invitations by domain.
+func (p B2BManagementPolicy) DomainsPolicy() (*InvitationsAllowedAndBlockedDomainsPolicy, error) {
+	definition, err := p.definition()
+	if err != nil {
+		return nil, err
+	}
+	v, ok := definition["InvitationsAllowedAndBlockedDomainsPolicy"]
+	if !ok {
+		return nil, nil
+	}
+	var domainsPolicy InvitationsAllowedAndBlockedDomainsPolicy
+	if err := json.Unmarshal(v, &domainsPolicy); err != nil {
+		return nil, err
+	}
+	return &domainsPolicy, nil
+}
+
+// SetDomainsPolicy replaces the invitation domain allow and deny lists in the policy definition, retaining any other
+// policy settings. Invitations can be restricted using either AllowedDomains or BlockedDomains, but not both.
+func (p *B2BManagementPolicy) SetDomainsPolicy(domainsPolicy InvitationsAllowedAndBlockedDomainsPolicy) error {
+	if domainsPolicy.AllowedDomains != nil && len(*domainsPolicy.AllowedDomains) > 0 && domainsPolicy.BlockedDomains != nil && len(*domainsPolicy.BlockedDomains) > 0 {
+		return goerrors.New("only one of AllowedDomains or BlockedDomains may be specified")
+	}
+
+	definition, err := p.definition()
+	if err != nil {
+		return err
+	}
+	v, err := json.Marshal(domainsPolicy)
+	if err != nil {
+		return err
+	}
+	definition["InvitationsAllowedAndBlockedDomainsPolicy"] = v
+
+	d, err := json.Marshal(map[string]map[string]json.RawMessage{"B2BManagementPolicy": definition})
+	if err != nil {
+		return err
+	}
+	p.Definition = &[]string{string(d)}
+
+	return nil
+}
+
+// definition returns the settings contained in the policy definition, keyed by name
+func (p B2BManagementPolicy) definition() (map[string]json.RawMessage, error) {
+	var definition map[string]map[string]json.RawMessage
+	if p.Definition != nil && len(*p.Definition) > 0 {
+		if err := json.Unmarshal([]byte((*p.Definition)[0]), &definition); err != nil {
+			return nil, err
+		}
+	}
+	if definition["B2BManagementPolicy"] == nil {
+		return map[string]json.RawMessage{}, nil
+	}
+	return definition["B2BManagementPolicy"], nil
+}
+
 type BaseNamedLocation struct {
 	ODataType        *odata.Type `json:"@odata.type,omitempty"`
 	ID               *string     `json:"id,omitempty"`
@@ -784,10 +1000,51 @@ func (c *ConditionalAccessGuestsOrExternalUsers) UnmarshalJSON(data []byte) erro
 	return nil
 }
 
+// Connector describes an application proxy connector installed on an on-premises server.
+type Connector struct {
+	ID          *string           `json:"id,omitempty"`
+	ExternalIp  *string           `json:"externalIp,omitempty"`
+	MachineName *string           `json:"machineName,omitempty"`
+	MemberOf    *[]ConnectorGroup `json:"memberOf,omitempty"`
+	Status      *ConnectorStatus  `json:"status,omitempty"`
+	Version     *string           `json:"version,omitempty"`
+}
+
+// ConnectorGroup describes a group of application proxy connectors, to which applications are assigned.
+type ConnectorGroup struct {
+	ID                 *string               `json:"id,omitempty"`
+	Applications       *[]Application        `json:"applications,omitempty"`
+	ConnectorGroupType *ConnectorGroupType   `json:"connectorGroupType,omitempty"`
+	IsDefault          *bool                 `json:"isDefault,omitempty"`
+	Members            *[]Connector          `json:"members,omitempty"`
+	Name               *string               `json:"name,omitempty"`
+	Region             *ConnectorGroupRegion `json:"region,omitempty"`
+}
+
 type ConnectionInfo struct {
 	Url *string `json:"url,omitempty"`
 }
 
+type ContainerFilter struct {
+	IncludedContainers *[]string `json:"includedContainers,omitempty"`
+}
+
+// ConversionUserDetails describes the result of converting an external user to an internal member user.
+type ConversionUserDetails struct {
+	ConvertedToInternalUserDateTime *time.Time `json:"convertedToInternalUserDateTime,omitempty"`
+	DisplayName                     *string    `json:"displayName,omitempty"`
+	Mail                            *string    `json:"mail,omitempty"`
+	UserPrincipalName               *string    `json:"userPrincipalName,omitempty"`
+}
+
+type CorsConfiguration struct {
+	AllowedHeaders  *[]string `json:"allowedHeaders,omitempty"`
+	AllowedMethods  *[]string `json:"allowedMethods,omitempty"`
+	AllowedOrigins  *[]string `json:"allowedOrigins,omitempty"`
+	MaxAgeInSeconds *int32    `json:"maxAgeInSeconds,omitempty"`
+	Resource        *string   `json:"resource,omitempty"`
+}
+
 // CountryNamedLocation describes an Country Named Location object.
 type CountryNamedLocation struct {
 	*BaseNamedLocation
@@ -795,6 +1052,12 @@ type CountryNamedLocation struct {
 	IncludeUnknownCountriesAndRegions *bool     `json:"includeUnknownCountriesAndRegions,omitempty"`
 }
 
+type Credential struct {
+	FieldId *string         `json:"fieldId,omitempty"`
+	Type    *CredentialType `json:"type,omitempty"`
+	Value   *string         `json:"value,omitempty"`
+}
+
 type CredentialUserRegistrationCount struct {
 	ID                     *string                  `json:"id,omitempty"`
 	TotalUserCount         *int64                   `json:"totalUserCount,omitempty"`
@@ -893,9 +1156,44 @@ func (d *DelegatedPermissionGrant) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// DeviceAndAppManagementAssignmentTarget describes the target of an Intune assignment. Set ODataType to indicate the
+// kind of target, or use one of the NewXxxAssignmentTarget() functions. GroupId is required for group and exclusion
+// group targets.
 type DeviceAndAppManagementAssignmentTarget struct {
+	ODataType                                  *odata.Type                                 `json:"@odata.type,omitempty"`
 	DeviceAndAppManagementAssignmentFilterId   *string                                     `json:"deviceAndAppManagementAssignmentFilterId,omitempty"`
 	DeviceAndAppManagementAssignmentFilterType *DeviceAndAppManagementAssignmentFilterType `json:"deviceAndAppManagementAssignmentFilterType,omitempty"`
+	GroupId                                    *string                                     `json:"groupId,omitempty"`
+}
+
+type Device struct {
+	DirectoryObject
+
+	AccountEnabled                *bool                    `json:"accountEnabled,omitempty"`
+	AlternativeSecurityIds        *[]AlternativeSecurityId `json:"alternativeSecurityIds,omitempty"`
+	ApproximateLastSignInDateTime *time.Time               `json:"approximateLastSignInDateTime,omitempty"`
+	ComplianceExpirationDateTime  *time.Time               `json:"complianceExpirationDateTime,omitempty"`
+	DeviceCategory                *string                  `json:"deviceCategory,omitempty"`
+	DeviceId                      *string                  `json:"deviceId,omitempty"`
+	DeviceMetadata                *string                  `json:"deviceMetadata,omitempty"`
+	DeviceOwnership               *string                  `json:"deviceOwnership,omitempty"`
+	DeviceVersion                 *int                     `json:"deviceVersion,omitempty"`
+	DisplayName                   *string                  `json:"displayName,omitempty"`
+	EnrollmentProfileName         *string                  `json:"enrollmentProfileName,omitempty"`
+	IsCompliant                   *bool                    `json:"isCompliant,omitempty"`
+	IsManaged                     *bool                    `json:"isManaged,omitempty"`
+	Manufacturer                  *string                  `json:"manufacturer,omitempty"`
+	MdmAppId                      *string                  `json:"mdmAppId,omitempty"`
+	Model                         *string                  `json:"model,omitempty"`
+	OnPremisesLastSyncDateTime    *time.Time               `json:"onPremisesLastSyncDateTime,omitempty"`
+	OnPremisesSyncEnabled         *bool                    `json:"onPremisesSyncEnabled,omitempty"`
+	OperatingSystem               *string                  `json:"operatingSystem,omitempty"`
+	OperatingSystemVersion        *string                  `json:"operatingSystemVersion,omitempty"`
+	PhysicalIds                   *[]string                `json:"physicalIds,omitempty"`
+	ProfileType                   *string                  `json:"profileType,omitempty"`
+	RegistrationDateTime          *time.Time               `json:"registrationDateTime,omitempty"`
+	SystemLabels                  *[]string                `json:"systemLabels,omitempty"`
+	TrustType                     *string                  `json:"trustType,omitempty"`
 }
 
 type DeviceDetail struct {
@@ -922,6 +1220,16 @@ type DirectoryAudit struct {
 	TargetResources     *[]TargetResource       `json:"targetResources,omitempty"`
 }
 
+type DirectoryDefinition struct {
+	Discoverabilities *string             `json:"discoverabilities,omitempty"`
+	DiscoveryDateTime *time.Time          `json:"discoveryDateTime,omitempty"`
+	ID                *string             `json:"id,omitempty"`
+	Name              *string             `json:"name,omitempty"`
+	Objects           *[]ObjectDefinition `json:"objects,omitempty"`
+	ReadOnly          *bool               `json:"readOnly,omitempty"`
+	Version           *string             `json:"version,omitempty"`
+}
+
 type DirectoryObject struct {
 	ODataId        *odata.Id              `json:"@odata.id,omitempty"`
 	ODataType      *odata.Type            `json:"@odata.type,omitempty"`
@@ -959,6 +1267,217 @@ func (o *DirectoryObject) Uri(endpoint string, apiVersion ApiVersion) string {
 	return fmt.Sprintf("%s/%s/directoryObjects/%s", endpoint, apiVersion, *o.Id)
 }
 
+// odataTypeOrgContact is not yet provided by the odata package
+const odataTypeOrgContact odata.Type = "#microsoft.graph.orgContact"
+
+// DirectoryObjectEntity is a concrete directory object, such as a *User, *Group, *ServicePrincipal, *Application,
+// *Device, *OrgContact, *DirectoryRole or *AdministrativeUnitEntity. Objects of any other type are represented by a *DirectoryObject, unless
+// the type has been registered with RegisterDirectoryObjectType.
+type DirectoryObjectEntity interface {
+	ID() *string
+}
+
+var (
+	directoryObjectTypesMutex sync.RWMutex
+	directoryObjectTypes      = map[odata.Type]func() DirectoryObjectEntity{
+		odata.TypeAdministrativeUnit: func() DirectoryObjectEntity { return &AdministrativeUnitEntity{} },
+		odata.TypeApplication:        func() DirectoryObjectEntity { return &Application{} },
+		odata.TypeDevice:             func() DirectoryObjectEntity { return &Device{} },
+		odata.TypeDirectoryRole:      func() DirectoryObjectEntity { return &DirectoryRole{} },
+		odata.TypeGroup:              func() DirectoryObjectEntity { return &Group{} },
+		odataTypeOrgContact:          func() DirectoryObjectEntity { return &OrgContact{} },
+		odata.TypeServicePrincipal:   func() DirectoryObjectEntity { return &ServicePrincipal{} },
+		odata.TypeUser:               func() DirectoryObjectEntity { return &User{} },
+	}
+)
+
+// RegisterDirectoryObjectType registers a model for the specified @odata.type, so that directory objects of that type
+// are unmarshaled into the value returned by newFunc. newFunc must return a pointer to a new zero value on each call.
+// Registering a type that is already known replaces the existing model.
+func RegisterDirectoryObjectType(odataType odata.Type, newFunc func() DirectoryObjectEntity) {
+	directoryObjectTypesMutex.Lock()
+	defer directoryObjectTypesMutex.Unlock()
+	directoryObjectTypes[odataType] = newFunc
+}
+
+// unmarshalDirectoryObjectEntity inspects the @odata.type of a directory object and unmarshals it into the matching model.
+func unmarshalDirectoryObjectEntity(data []byte) (DirectoryObjectEntity, error) {
+	var o odata.OData
+	if err := json.Unmarshal(data, &o); err != nil {
+		return nil, err
+	}
+
+	var entity DirectoryObjectEntity
+	if o.Type != nil {
+		directoryObjectTypesMutex.RLock()
+		newFunc, ok := directoryObjectTypes[*o.Type]
+		directoryObjectTypesMutex.RUnlock()
+		if ok {
+			entity = newFunc()
+		}
+	}
+
+	if entity == nil {
+		directoryObject := &DirectoryObject{}
+		if err := directoryObject.UnmarshalJSONWithAdditionalData(data); err != nil {
+			return nil, err
+		}
+		return directoryObject, nil
+	}
+
+	if err := json.Unmarshal(data, entity); err != nil {
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// unmarshalDirectoryObjects unmarshals a collection of mixed directory objects from a response body.
+func unmarshalDirectoryObjects(respBody []byte) (*DirectoryObjects, error) {
+	var data struct {
+		Objects DirectoryObjects `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, err
+	}
+	if data.Objects == nil {
+		data.Objects = DirectoryObjects{}
+	}
+	return &data.Objects, nil
+}
+
+// DirectoryObjects is a collection of mixed directory objects, each unmarshaled into a concrete model according to its
+// @odata.type. Use a type switch on the elements, or one of the helper methods, to access the concrete models.
+type DirectoryObjects []DirectoryObjectEntity
+
+func (o *DirectoryObjects) UnmarshalJSON(data []byte) error {
+	var objects []json.RawMessage
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return err
+	}
+	ret := make(DirectoryObjects, 0, len(objects))
+	for _, v := range objects {
+		entity, err := unmarshalDirectoryObjectEntity(v)
+		if err != nil {
+			return err
+		}
+		ret = append(ret, entity)
+	}
+	*o = ret
+	return nil
+}
+
+// IDs returns the object IDs of all the directory objects in the collection.
+func (o DirectoryObjects) IDs() []string {
+	ret := make([]string, 0, len(o))
+	for _, v := range o {
+		if id := v.ID(); id != nil {
+			ret = append(ret, *id)
+		}
+	}
+	return ret
+}
+
+// AdministrativeUnits returns the administrative units in the collection.
+func (o DirectoryObjects) AdministrativeUnits() []*AdministrativeUnit {
+	ret := make([]*AdministrativeUnit, 0)
+	for _, v := range o {
+		if administrativeUnit, ok := v.(*AdministrativeUnitEntity); ok {
+			ret = append(ret, &administrativeUnit.AdministrativeUnit)
+		}
+	}
+	return ret
+}
+
+// Applications returns the applications in the collection.
+func (o DirectoryObjects) Applications() []*Application {
+	ret := make([]*Application, 0)
+	for _, v := range o {
+		if application, ok := v.(*Application); ok {
+			ret = append(ret, application)
+		}
+	}
+	return ret
+}
+
+// Devices returns the devices in the collection.
+func (o DirectoryObjects) Devices() []*Device {
+	ret := make([]*Device, 0)
+	for _, v := range o {
+		if device, ok := v.(*Device); ok {
+			ret = append(ret, device)
+		}
+	}
+	return ret
+}
+
+// DirectoryRoles returns the directory roles in the collection.
+func (o DirectoryObjects) DirectoryRoles() []*DirectoryRole {
+	ret := make([]*DirectoryRole, 0)
+	for _, v := range o {
+		if directoryRole, ok := v.(*DirectoryRole); ok {
+			ret = append(ret, directoryRole)
+		}
+	}
+	return ret
+}
+
+// Groups returns the groups in the collection.
+func (o DirectoryObjects) Groups() []*Group {
+	ret := make([]*Group, 0)
+	for _, v := range o {
+		if group, ok := v.(*Group); ok {
+			ret = append(ret, group)
+		}
+	}
+	return ret
+}
+
+// OrgContacts returns the organizational contacts in the collection.
+func (o DirectoryObjects) OrgContacts() []*OrgContact {
+	ret := make([]*OrgContact, 0)
+	for _, v := range o {
+		if orgContact, ok := v.(*OrgContact); ok {
+			ret = append(ret, orgContact)
+		}
+	}
+	return ret
+}
+
+// ServicePrincipals returns the service principals in the collection.
+func (o DirectoryObjects) ServicePrincipals() []*ServicePrincipal {
+	ret := make([]*ServicePrincipal, 0)
+	for _, v := range o {
+		if servicePrincipal, ok := v.(*ServicePrincipal); ok {
+			ret = append(ret, servicePrincipal)
+		}
+	}
+	return ret
+}
+
+// Users returns the users in the collection.
+func (o DirectoryObjects) Users() []*User {
+	ret := make([]*User, 0)
+	for _, v := range o {
+		if user, ok := v.(*User); ok {
+			ret = append(ret, user)
+		}
+	}
+	return ret
+}
+
+// Unknown returns any directory objects in the collection having a type that is not known or registered. These are
+// represented as a *DirectoryObject, with any unrecognised properties populated in AdditionalData.
+func (o DirectoryObjects) Unknown() []*DirectoryObject {
+	ret := make([]*DirectoryObject, 0)
+	for _, v := range o {
+		if directoryObject, ok := v.(*DirectoryObject); ok {
+			ret = append(ret, directoryObject)
+		}
+	}
+	return ret
+}
+
 type DirectoryRole struct {
 	DirectoryObject
 	Members *Members `json:"-"`
@@ -1024,6 +1543,31 @@ type EntitlementManagementSchedule struct {
 	Recurrence    *RecurrencePattern `json:"recurrence,omitempty"`
 }
 
+type EvaluateDynamicMembershipResult struct {
+	MembershipRule                  *string                      `json:"membershipRule,omitempty"`
+	MembershipRuleEvaluationDetails *ExpressionEvaluationDetails `json:"membershipRuleEvaluationDetails,omitempty"`
+	MembershipRuleEvaluationResult  *bool                        `json:"membershipRuleEvaluationResult,omitempty"`
+}
+
+type ExpressionEvaluationDetails struct {
+	Expression                  *string                        `json:"expression,omitempty"`
+	ExpressionEvaluationDetails *[]ExpressionEvaluationDetails `json:"expressionEvaluationDetails,omitempty"`
+	ExpressionResult            *bool                          `json:"expressionResult,omitempty"`
+	PropertyToEvaluate          *PropertyToEvaluate            `json:"propertyToEvaluate,omitempty"`
+}
+
+type ExpressionInputObject struct {
+	Definition *ObjectDefinition           `json:"definition,omitempty"`
+	Properties *[]StringKeyObjectValuePair `json:"properties,omitempty"`
+}
+
+type ExternalIdentitiesPolicy struct {
+	AllowDeletedIdentitiesDataRemoval *bool   `json:"allowDeletedIdentitiesDataRemoval,omitempty"`
+	AllowExternalIdentitiesToLeave    *bool   `json:"allowExternalIdentitiesToLeave,omitempty"`
+	DisplayName                       *string `json:"displayName,omitempty"`
+	ID                                *string `json:"id,omitempty"`
+}
+
 type ExtensionSchemaProperty struct {
 	Name *string                         `json:"name,omitempty"`
 	Type ExtensionSchemaPropertyDataType `json:"type,omitempty"`
@@ -1036,12 +1580,20 @@ type ExpirationPattern struct {
 }
 
 type FederatedIdentityCredential struct {
-	Audiences   *[]string            `json:"audiences,omitempty"`
-	Description *StringNullWhenEmpty `json:"description,omitempty"`
-	ID          *string              `json:"id,omitempty"`
-	Issuer      *string              `json:"issuer,omitempty"`
-	Name        *string              `json:"name,omitempty"`
-	Subject     *string              `json:"subject,omitempty"`
+	Audiences                *[]string                    `json:"audiences,omitempty"`
+	ClaimsMatchingExpression *FederatedIdentityExpression `json:"claimsMatchingExpression,omitempty"`
+	Description              *StringNullWhenEmpty         `json:"description,omitempty"`
+	ID                       *string                      `json:"id,omitempty"`
+	Issuer                   *string                      `json:"issuer,omitempty"`
+	Name                     *string                      `json:"name,omitempty"`
+	Subject                  *string                      `json:"subject,omitempty"`
+}
+
+// FederatedIdentityExpression is a flexible federated identity credential expression, used in place of a Subject to
+// match multiple subjects or other claims, e.g. `claims['sub'] matches 'repo:contoso/*'`.
+type FederatedIdentityExpression struct {
+	LanguageVersion *int32  `json:"languageVersion,omitempty"`
+	Value           *string `json:"value,omitempty"`
 }
 
 type Fido2AuthenticationMethod struct {
@@ -1054,6 +1606,34 @@ type Fido2AuthenticationMethod struct {
 	AttestationLevel        *AttestationLevel `json:"attestationLevel,omitempty"`
 }
 
+type Filter struct {
+	CategoryFilterGroups *[]FilterGroup `json:"categoryFilterGroups,omitempty"`
+	Groups               *[]FilterGroup `json:"groups,omitempty"`
+	InputFilterGroups    *[]FilterGroup `json:"inputFilterGroups,omitempty"`
+}
+
+type FilterClause struct {
+	OperatorName      *string        `json:"operatorName,omitempty"`
+	SourceOperandName *string        `json:"sourceOperandName,omitempty"`
+	TargetOperand     *FilterOperand `json:"targetOperand,omitempty"`
+}
+
+type FilterGroup struct {
+	Clauses *[]FilterClause `json:"clauses,omitempty"`
+	Name    *string         `json:"name,omitempty"`
+}
+
+type FilterOperand struct {
+	Values *[]string `json:"values,omitempty"`
+}
+
+type FilterOperatorSchema struct {
+	Arity                     *ScopeOperatorType                      `json:"arity,omitempty"`
+	MultivaluedComparisonType *ScopeOperatorMultiValuedComparisonType `json:"multivaluedComparisonType,omitempty"`
+	Name                      *string                                 `json:"name,omitempty"`
+	SupportedAttributeTypes   *[]AttributeType                        `json:"supportedAttributeTypes,omitempty"`
+}
+
 type GeoCoordinates struct {
 	Altitude  *float64 `json:"altitude,omitempty"`
 	Latitude  *float64 `json:"latitude,omitempty"`
@@ -1067,50 +1647,55 @@ type Group struct {
 	Owners           *Owners                `json:"owners@odata.bind,omitempty"`
 	SchemaExtensions *[]SchemaExtensionData `json:"-"`
 
-	AllowExternalSenders          *bool                               `json:"allowExternalSenders,omitempty"`
-	AssignedLabels                *[]GroupAssignedLabel               `json:"assignedLabels,omitempty"`
-	AssignedLicenses              *[]GroupAssignedLicense             `json:"assignLicenses,omitempty"`
-	AutoSubscribeNewMembers       *bool                               `json:"autoSubscribeNewMembers,omitempty"`
-	Classification                *string                             `json:"classification,omitempty"`
-	CreatedDateTime               *time.Time                          `json:"createdDateTime,omitempty"`
-	DeletedDateTime               *time.Time                          `json:"deletedDateTime,omitempty"`
-	Description                   *StringNullWhenEmpty                `json:"description,omitempty"`
-	DisplayName                   *string                             `json:"displayName,omitempty"`
-	ExpirationDateTime            *time.Time                          `json:"expirationDateTime,omitempty"`
-	GroupTypes                    *[]GroupType                        `json:"groupTypes,omitempty"`
-	HasMembersWithLicenseErrors   *bool                               `json:"hasMembersWithLicenseErrors,omitempty"`
-	HideFromAddressLists          *bool                               `json:"hideFromAddressLists,omitempty"`
-	HideFromOutlookClients        *bool                               `json:"hideFromOutlookClients,omitempty"`
-	IsAssignableToRole            *bool                               `json:"isAssignableToRole,omitempty"`
-	IsSubscribedByMail            *bool                               `json:"isSubscribedByMail,omitempty"`
-	LicenseProcessingState        *string                             `json:"licenseProcessingState,omitempty"`
-	Mail                          *string                             `json:"mail,omitempty"`
-	MailEnabled                   *bool                               `json:"mailEnabled,omitempty"`
-	MailNickname                  *string                             `json:"mailNickname,omitempty"`
-	MembershipRule                *StringNullWhenEmpty                `json:"membershipRule,omitempty"`
-	MembershipRuleProcessingState *GroupMembershipRuleProcessingState `json:"membershipRuleProcessingState,omitempty"`
-	OnPremisesDomainName          *string                             `json:"onPremisesDomainName,omitempty"`
-	OnPremisesLastSyncDateTime    *time.Time                          `json:"onPremisesLastSyncDateTime,omitempty"`
-	OnPremisesNetBiosName         *string                             `json:"onPremisesNetBiosName,omitempty"`
-	OnPremisesProvisioningErrors  *[]GroupOnPremisesProvisioningError `json:"onPremisesProvisioningErrors,omitempty"`
-	OnPremisesSamAccountName      *string                             `json:"onPremisesSamAccountName,omitempty"`
-	OnPremisesSecurityIdentifier  *string                             `json:"onPremisesSecurityIdentifier,omitempty"`
-	OnPremisesSyncEnabled         *bool                               `json:"onPremisesSyncEnabled,omitempty"`
-	PreferredDataLocation         *string                             `json:"preferredDataLocation,omitempty"`
-	PreferredLanguage             *string                             `json:"preferredLanguage,omitempty"`
-	ProxyAddresses                *[]string                           `json:"proxyAddresses,omitempty"`
-	RenewedDateTime               *time.Time                          `json:"renewedDateTime,omitempty"`
-	ResourceBehaviorOptions       *[]GroupResourceBehaviorOption      `json:"resourceBehaviorOptions,omitempty"`
-	ResourceProvisioningOptions   *[]GroupResourceProvisioningOption  `json:"resourceProvisioningOptions,omitempty"`
-	SecurityEnabled               *bool                               `json:"securityEnabled,omitempty"`
-	SecurityIdentifier            *string                             `json:"securityIdentifier,omitempty"`
-	Theme                         *GroupTheme                         `json:"theme,omitempty"`
-	UnseenCount                   *int                                `json:"unseenCount,omitempty"`
-	Visibility                    *GroupVisibility                    `json:"visibility,omitempty"`
-	WritebackConfiguration        *GroupWritebackConfiguration        `json:"writebackConfiguration,omitempty"`
+	AllowExternalSenders           *bool                               `json:"allowExternalSenders,omitempty"`
+	AssignedLabels                 *[]GroupAssignedLabel               `json:"assignedLabels,omitempty"`
+	AssignedLicenses               *[]GroupAssignedLicense             `json:"assignLicenses,omitempty"`
+	AutoSubscribeNewMembers        *bool                               `json:"autoSubscribeNewMembers,omitempty"`
+	Classification                 *string                             `json:"classification,omitempty"`
+	CreatedDateTime                *time.Time                          `json:"createdDateTime,omitempty"`
+	DeletedDateTime                *time.Time                          `json:"deletedDateTime,omitempty"`
+	Description                    *StringNullWhenEmpty                `json:"description,omitempty"`
+	DisplayName                    *string                             `json:"displayName,omitempty"`
+	ExpirationDateTime             *time.Time                          `json:"expirationDateTime,omitempty"`
+	Extensions                     *[]OpenTypeExtension                `json:"extensions,omitempty"`
+	GroupTypes                     *[]GroupType                        `json:"groupTypes,omitempty"`
+	HasMembersWithLicenseErrors    *bool                               `json:"hasMembersWithLicenseErrors,omitempty"`
+	HideFromAddressLists           *bool                               `json:"hideFromAddressLists,omitempty"`
+	HideFromOutlookClients         *bool                               `json:"hideFromOutlookClients,omitempty"`
+	IsAssignableToRole             *bool                               `json:"isAssignableToRole,omitempty"`
+	IsSubscribedByMail             *bool                               `json:"isSubscribedByMail,omitempty"`
+	LicenseProcessingState         *string                             `json:"licenseProcessingState,omitempty"`
+	Mail                           *string                             `json:"mail,omitempty"`
+	MailEnabled                    *bool                               `json:"mailEnabled,omitempty"`
+	MailNickname                   *string                             `json:"mailNickname,omitempty"`
+	MembershipRule                 *StringNullWhenEmpty                `json:"membershipRule,omitempty"`
+	MembershipRuleProcessingState  *GroupMembershipRuleProcessingState `json:"membershipRuleProcessingState,omitempty"`
+	MembershipRuleProcessingStatus *MembershipRuleProcessingStatus     `json:"membershipRuleProcessingStatus,omitempty"`
+	OnPremisesDomainName           *string                             `json:"onPremisesDomainName,omitempty"`
+	OnPremisesLastSyncDateTime     *time.Time                          `json:"onPremisesLastSyncDateTime,omitempty"`
+	OnPremisesNetBiosName          *string                             `json:"onPremisesNetBiosName,omitempty"`
+	OnPremisesProvisioningErrors   *[]GroupOnPremisesProvisioningError `json:"onPremisesProvisioningErrors,omitempty"`
+	OnPremisesSamAccountName       *string                             `json:"onPremisesSamAccountName,omitempty"`
+	OnPremisesSecurityIdentifier   *string                             `json:"onPremisesSecurityIdentifier,omitempty"`
+	OnPremisesSyncEnabled          *bool                               `json:"onPremisesSyncEnabled,omitempty"`
+	PreferredDataLocation          *string                             `json:"preferredDataLocation,omitempty"`
+	PreferredLanguage              *string                             `json:"preferredLanguage,omitempty"`
+	ProxyAddresses                 *[]string                           `json:"proxyAddresses,omitempty"`
+	RenewedDateTime                *time.Time                          `json:"renewedDateTime,omitempty"`
+	ResourceBehaviorOptions        *[]GroupResourceBehaviorOption      `json:"resourceBehaviorOptions,omitempty"`
+	ResourceProvisioningOptions    *[]GroupResourceProvisioningOption  `json:"resourceProvisioningOptions,omitempty"`
+	SecurityEnabled                *bool                               `json:"securityEnabled,omitempty"`
+	SecurityIdentifier             *string                             `json:"securityIdentifier,omitempty"`
+	Theme                          *GroupTheme                         `json:"theme,omitempty"`
+	UnseenCount                    *int                                `json:"unseenCount,omitempty"`
+	Visibility                     *GroupVisibility                    `json:"visibility,omitempty"`
+	WritebackConfiguration         *GroupWritebackConfiguration        `json:"writebackConfiguration,omitempty"`
 }
 
 func (g Group) MarshalJSON() ([]byte, error) {
+	// Extensions is a read-only navigation property, open extensions are managed with OpenExtensionsClient
+	g.Extensions = nil
+
 	docs := make([][]byte, 0)
 	// Local type needed to avoid recursive MarshalJSON calls
 	type group Group
@@ -1181,6 +1766,10 @@ type GroupAssignedLicense struct {
 	SkuId         *string   `json:"skuId,omitempty"`
 }
 
+type GroupFilter struct {
+	IncludedGroups *[]string `json:"includedGroups,omitempty"`
+}
+
 type GroupOnPremisesProvisioningError struct {
 	Category             *string   `json:"category,omitempty"`
 	OccurredDateTime     time.Time `json:"occurredDateTime,omitempty"`
@@ -1193,12 +1782,40 @@ type GroupWritebackConfiguration struct {
 	OnPremisesGroupType *OnPremisesGroupType `json:"onPremisesGroupType"`
 }
 
+type HardwareOathAuthenticationMethod struct {
+	CreatedDateTime *time.Time                                   `json:"createdDateTime,omitempty"`
+	Device          *HardwareOathTokenAuthenticationMethodDevice `json:"device,omitempty"`
+	ID              *string                                      `json:"id,omitempty"`
+}
+
+type HardwareOathTokenAuthenticationMethodDevice struct {
+	AssignTo              *Identity                      `json:"assignTo,omitempty"`
+	AssignedTo            *Identity                      `json:"assignedTo,omitempty"`
+	DisplayName           *string                        `json:"displayName,omitempty"`
+	HashFunction          *HardwareOathTokenHashFunction `json:"hashFunction,omitempty"`
+	ID                    *string                        `json:"id,omitempty"`
+	LastUsedDateTime      *time.Time                     `json:"lastUsedDateTime,omitempty"`
+	Manufacturer          *string                        `json:"manufacturer,omitempty"`
+	Model                 *string                        `json:"model,omitempty"`
+	SecretKey             *string                        `json:"secretKey,omitempty"`
+	SerialNumber          *string                        `json:"serialNumber,omitempty"`
+	Status                *HardwareOathTokenStatus       `json:"status,omitempty"`
+	TimeIntervalInSeconds *int                           `json:"timeIntervalInSeconds,omitempty"`
+}
+
 type Identity struct {
 	DisplayName *string `json:"displayName,omitempty"`
 	Id          *string `json:"id,omitempty"`
 	TenantId    *string `json:"tenantId,omitempty"`
 }
 
+type IdentityApiConnector struct {
+	AuthenticationConfiguration *ApiAuthenticationConfiguration `json:"authenticationConfiguration,omitempty"`
+	DisplayName                 *string                         `json:"displayName,omitempty"`
+	ID                          *string                         `json:"id,omitempty"`
+	TargetUrl                   *string                         `json:"targetUrl,omitempty"`
+}
+
 type IdentityProvider struct {
 	ODataType    *odata.Type `json:"@odata.type,omitempty"`
 	ID           *string     `json:"id,omitempty"`
@@ -1217,11 +1834,41 @@ type IdentitySource struct {
 	IssuerUri   *string     `json:"issuerUri,omitempty"`
 }
 
+type IdentityUserFlowAttributeAssignment struct {
+	DisplayName          *string                     `json:"displayName,omitempty"`
+	ID                   *string                     `json:"id,omitempty"`
+	IsOptional           *bool                       `json:"isOptional,omitempty"`
+	RequiresVerification *bool                       `json:"requiresVerification,omitempty"`
+	UserAttribute        *UserFlowAttribute          `json:"userAttribute,omitempty"`
+	UserAttributeValues  *[]UserAttributeValuesItem  `json:"userAttributeValues,omitempty"`
+	UserInputType        *UserFlowAttributeInputType `json:"userInputType,omitempty"`
+}
+
 type ImplicitGrantSettings struct {
 	EnableAccessTokenIssuance *bool `json:"enableAccessTokenIssuance,omitempty"`
 	EnableIdTokenIssuance     *bool `json:"enableIdTokenIssuance,omitempty"`
 }
 
+// ImportedWindowsAutopilotDeviceIdentity describes a device being imported into Windows Autopilot from its hardware
+// hash. HardwareIdentifier is the base64 encoded hardware hash, as found in the hardware hash CSV file.
+type ImportedWindowsAutopilotDeviceIdentity struct {
+	AssignedUserPrincipalName *string                                      `json:"assignedUserPrincipalName,omitempty"`
+	GroupTag                  *string                                      `json:"groupTag,omitempty"`
+	HardwareIdentifier        *string                                      `json:"hardwareIdentifier,omitempty"`
+	ID                        *string                                      `json:"id,omitempty"`
+	ImportId                  *string                                      `json:"importId,omitempty"`
+	ProductKey                *string                                      `json:"productKey,omitempty"`
+	SerialNumber              *string                                      `json:"serialNumber,omitempty"`
+	State                     *ImportedWindowsAutopilotDeviceIdentityState `json:"state,omitempty"`
+}
+
+type ImportedWindowsAutopilotDeviceIdentityState struct {
+	DeviceErrorCode      *int32                                              `json:"deviceErrorCode,omitempty"`
+	DeviceErrorName      *string                                             `json:"deviceErrorName,omitempty"`
+	DeviceImportStatus   *ImportedWindowsAutopilotDeviceIdentityImportStatus `json:"deviceImportStatus,omitempty"`
+	DeviceRegistrationId *string                                             `json:"deviceRegistrationId,omitempty"`
+}
+
 type InformationalUrl struct {
 	LogoUrl             *StringNullWhenEmpty `json:"logoUrl,omitempty"`
 	MarketingUrl        *StringNullWhenEmpty `json:"marketingUrl"`
@@ -1240,11 +1887,19 @@ type Invitation struct {
 	InviteRedeemURL         *string          `json:"inviteRedeemUrl,omitempty"`
 	Status                  *string          `json:"status,omitempty"`
 	InvitedUserType         *InvitedUserType `json:"invitedUserType,omitempty"`
+	ResetRedemption         *bool            `json:"resetRedemption,omitempty"`
 
 	InvitedUserMessageInfo *InvitedUserMessageInfo `json:"invitedUserMessageInfo,omitempty"`
 	InvitedUser            *User                   `json:"invitedUser,omitempty"`
 }
 
+// InvitationsAllowedAndBlockedDomainsPolicy restricts the domains to which B2B invitations can be sent, using either
+// an allow list or a deny list.
+type InvitationsAllowedAndBlockedDomainsPolicy struct {
+	AllowedDomains *[]string `json:"AllowedDomains,omitempty"`
+	BlockedDomains *[]string `json:"BlockedDomains,omitempty"`
+}
+
 type InvitedUserMessageInfo struct {
 	CCRecipients          *[]Recipient `json:"ccRecipients,omitempty"`
 	CustomizedMessageBody *string      `json:"customizedMessageBody,omitempty"`
@@ -1297,6 +1952,15 @@ type Location struct {
 	State           *string         `json:"state,omitempty"`
 }
 
+type LongRunningOperation struct {
+	CreatedDateTime    *time.Time                  `json:"createdDateTime,omitempty"`
+	ID                 *string                     `json:"id,omitempty"`
+	LastActionDateTime *time.Time                  `json:"lastActionDateTime,omitempty"`
+	ResourceLocation   *string                     `json:"resourceLocation,omitempty"`
+	Status             *LongRunningOperationStatus `json:"status,omitempty"`
+	StatusDetail       *string                     `json:"statusDetail,omitempty"`
+}
+
 type MailMessage struct {
 	Message *Message `json:"message,omitempty"`
 }
@@ -1308,6 +1972,12 @@ type Me struct {
 	UserPrincipalName *string `json:"userPrincipalName"`
 }
 
+type MembershipRuleProcessingStatus struct {
+	ErrorMessage          *string                                `json:"errorMessage,omitempty"`
+	LastMembershipUpdated *time.Time                             `json:"lastMembershipUpdated,omitempty"`
+	Status                *MembershipRuleProcessingStatusDetails `json:"status,omitempty"`
+}
+
 type Message struct {
 	ID            *string      `json:"id,omitempty"`
 	Subject       *string      `json:"subject,omitempty"`
@@ -1334,20 +2004,40 @@ type ModifiedProperty struct {
 
 type NamedLocation interface{}
 
-type OnPremisesPublishing struct {
-	AlternateUrl                  *string `json:"alternateUrl,omitempty"`
-	ApplicationServerTimeout      *string `json:"applicationServerTimeout,omitempty"`
-	ApplicationType               *string `json:"applicationType,omitempty"`
-	ExternalAuthenticationType    *string `json:"externalAuthenticationType,omitempty"`
-	ExternalUrl                   *string `json:"externalUrl,omitempty"`
-	InternalUrl                   *string `json:"internalUrl,omitempty"`
-	IsHttpOnlyCookieEnabled       *bool   `json:"isHttpOnlyCookieEnabled,omitempty"`
-	IsOnPremPublishingEnabled     *bool   `json:"isOnPremPublishingEnabled,omitempty"`
-	IsPersistentCookieEnabled     *bool   `json:"isPersistentCookieEnabled,omitempty"`
-	IsSecureCookieEnabled         *bool   `json:"isSecureCookieEnabled,omitempty"`
-	IsTranslateHostHeaderEnabled  *bool   `json:"isTranslateHostHeaderEnabled,omitempty"`
-	IsTranslateLinksInBodyEnabled *bool   `json:"isTranslateLinksInBodyEnabled,omitempty"`
+type ObjectDefinition struct {
+	Attributes    *[]AttributeDefinition          `json:"attributes,omitempty"`
+	Metadata      *[]SynchronizationMetadataEntry `json:"metadata,omitempty"`
+	Name          *string                         `json:"name,omitempty"`
+	SupportedApis *[]string                       `json:"supportedApis,omitempty"`
+}
 
+type ObjectMapping struct {
+	AttributeMappings *[]AttributeMapping             `json:"attributeMappings,omitempty"`
+	Enabled           *bool                           `json:"enabled,omitempty"`
+	FlowTypes         *string                         `json:"flowTypes,omitempty"`
+	Metadata          *[]SynchronizationMetadataEntry `json:"metadata,omitempty"`
+	Name              *string                         `json:"name,omitempty"`
+	Scope             *Filter                         `json:"scope,omitempty"`
+	SourceObjectName  *string                         `json:"sourceObjectName,omitempty"`
+	TargetObjectName  *string                         `json:"targetObjectName,omitempty"`
+}
+
+type OnPremisesPublishing struct {
+	AlternateUrl                          *string                                         `json:"alternateUrl,omitempty"`
+	ApplicationServerTimeout              *OnPremisesPublishingApplicationServerTimeout   `json:"applicationServerTimeout,omitempty"`
+	ApplicationType                       *string                                         `json:"applicationType,omitempty"`
+	ExternalAuthenticationType            *OnPremisesPublishingExternalAuthenticationType `json:"externalAuthenticationType,omitempty"`
+	ExternalUrl                           *string                                         `json:"externalUrl,omitempty"`
+	InternalUrl                           *string                                         `json:"internalUrl,omitempty"`
+	IsBackendCertificateValidationEnabled *bool                                           `json:"isBackendCertificateValidationEnabled,omitempty"`
+	IsHttpOnlyCookieEnabled               *bool                                           `json:"isHttpOnlyCookieEnabled,omitempty"`
+	IsOnPremPublishingEnabled             *bool                                           `json:"isOnPremPublishingEnabled,omitempty"`
+	IsPersistentCookieEnabled             *bool                                           `json:"isPersistentCookieEnabled,omitempty"`
+	IsSecureCookieEnabled                 *bool                                           `json:"isSecureCookieEnabled,omitempty"`
+	IsTranslateHostHeaderEnabled          *bool                                           `json:"isTranslateHostHeaderEnabled,omitempty"`
+	IsTranslateLinksInBodyEnabled         *bool                                           `json:"isTranslateLinksInBodyEnabled,omitempty"`
+
+	SegmentsConfiguration                    *SegmentConfiguration                                         `json:"segmentsConfiguration,omitempty"`
 	SingleSignOnSettings                     *OnPremisesPublishingSingleSignOn                             `json:"singleSignOnSettings,omitempty"`
 	VerifiedCustomDomainCertificatesMetadata *OnPremisesPublishingVerifiedCustomDomainCertificatesMetadata `json:"verifiedCustomDomainCertificatesMetadata,omitempty"`
 	VerifiedCustomDomainKeyCredential        *KeyCredential                                                `json:"verifiedCustomDomainKeyCredential,omitempty"`
@@ -1380,6 +2070,23 @@ type OptionalClaims struct {
 	Saml2Token  *[]OptionalClaim `json:"saml2Token,omitempty"`
 }
 
+type OrgContact struct {
+	DirectoryObject
+
+	Addresses             *[]PhysicalOfficeAddress `json:"addresses,omitempty"`
+	CompanyName           *string                  `json:"companyName,omitempty"`
+	Department            *string                  `json:"department,omitempty"`
+	DisplayName           *string                  `json:"displayName,omitempty"`
+	GivenName             *string                  `json:"givenName,omitempty"`
+	JobTitle              *string                  `json:"jobTitle,omitempty"`
+	Mail                  *string                  `json:"mail,omitempty"`
+	MailNickname          *string                  `json:"mailNickname,omitempty"`
+	OnPremisesSyncEnabled *bool                    `json:"onPremisesSyncEnabled,omitempty"`
+	Phones                *[]Phone                 `json:"phones,omitempty"`
+	ProxyAddresses        *[]string                `json:"proxyAddresses,omitempty"`
+	Surname               *string                  `json:"surname,omitempty"`
+}
+
 type OutOfBoxExperienceSettings struct {
 	HidePrivacySettings       *bool            `json:"hidePrivacySettings,omitempty"`
 	HideEULA                  *bool            `json:"hideEULA,omitempty"`
@@ -1394,6 +2101,20 @@ type ParentalControlSettings struct {
 	LegalAgeGroupRule         *string   `json:"legalAgeGroupRule,omitempty"`
 }
 
+type ParseExpressionRequest struct {
+	Expression                *string                `json:"expression,omitempty"`
+	TargetAttributeDefinition *AttributeDefinition   `json:"targetAttributeDefinition,omitempty"`
+	TestInputObject           *ExpressionInputObject `json:"testInputObject,omitempty"`
+}
+
+type ParseExpressionResponse struct {
+	Error               *odata.Error            `json:"error,omitempty"`
+	EvaluationResult    *[]string               `json:"evaluationResult,omitempty"`
+	EvaluationSucceeded *bool                   `json:"evaluationSucceeded,omitempty"`
+	ParsedExpression    *AttributeMappingSource `json:"parsedExpression,omitempty"`
+	ParsingSucceeded    *bool                   `json:"parsingSucceeded,omitempty"`
+}
+
 // PasswordCredential describes a password credential for an object.
 type PasswordCredential struct {
 	CustomKeyIdentifier *string    `json:"customKeyIdentifier,omitempty"`
@@ -1411,6 +2132,18 @@ type PasswordAuthenticationMethod struct {
 	Password         *string    `json:"password,omitempty"`
 }
 
+// PasswordResetResponse describes the result of resetting a password authentication method. NewPassword is only
+// populated when the password was generated by the service.
+type PasswordResetResponse struct {
+	NewPassword *string `json:"newPassword,omitempty"`
+	OperationId *string `json:"-"`
+}
+
+type PasswordSingleSignOnCredentialSet struct {
+	ID          *string       `json:"id,omitempty"`
+	Credentials *[]Credential `json:"credentials,omitempty"`
+}
+
 type PasswordSingleSignOnSettings struct {
 	Fields *[]SingleSignOnField `json:"fields,omitempty"`
 }
@@ -1420,6 +2153,7 @@ type PermissionScope struct {
 	AdminConsentDescription *string             `json:"adminConsentDescription,omitempty"`
 	AdminConsentDisplayName *string             `json:"adminConsentDisplayName,omitempty"`
 	IsEnabled               *bool               `json:"isEnabled,omitempty"`
+	Origin                  *string             `json:"origin,omitempty"`
 	Type                    PermissionScopeType `json:"type,omitempty"`
 	UserConsentDescription  *string             `json:"userConsentDescription,omitempty"`
 	UserConsentDisplayName  *string             `json:"userConsentDisplayName,omitempty"`
@@ -1431,12 +2165,34 @@ type PersistentBrowserSessionControl struct {
 	Mode      *PersistentBrowserSessionMode `json:"mode,omitempty"`
 }
 
+type Phone struct {
+	Number *string    `json:"number,omitempty"`
+	Type   *PhoneType `json:"type,omitempty"`
+}
+
 type PhoneAuthenticationMethod struct {
 	ID          *string                  `json:"id,omitempty"`
 	PhoneNumber *string                  `json:"phoneNumber,omitempty"`
 	PhoneType   *AuthenticationPhoneType `json:"phoneType,omitempty"`
 }
 
+type PhysicalOfficeAddress struct {
+	City            *string `json:"city,omitempty"`
+	CountryOrRegion *string `json:"countryOrRegion,omitempty"`
+	OfficeLocation  *string `json:"officeLocation,omitempty"`
+	PostalCode      *string `json:"postalCode,omitempty"`
+	State           *string `json:"state,omitempty"`
+	Street          *string `json:"street,omitempty"`
+}
+
+type PlatformCredentialAuthenticationMethod struct {
+	CreatedDateTime *time.Time                       `json:"createdDateTime,omitempty"`
+	DisplayName     *string                          `json:"displayName,omitempty"`
+	ID              *string                          `json:"id,omitempty"`
+	KeyStrength     *AuthenticationMethodKeyStrength `json:"keyStrength,omitempty"`
+	Platform        *AuthenticationMethodPlatform    `json:"platform,omitempty"`
+}
+
 type PrivilegedAccessGroupAssignmentSchedule struct {
 	ID               *string                               `json:"id,omitempty"`
 	AccessId         PrivilegedAccessGroupRelationship     `json:"accessId,omitempty"`
@@ -1523,6 +2279,83 @@ type PrivilegedAccessGroupEligibilityScheduleRequest struct {
 	TicketInfo        *TicketInfo                            `json:"ticketInfo,omitempty"`
 }
 
+type PropertyToEvaluate struct {
+	PropertyName  *string `json:"propertyName,omitempty"`
+	PropertyValue *string `json:"propertyValue,omitempty"`
+}
+
+type Pkcs12CertificateInformation struct {
+	IsActive   *bool   `json:"isActive,omitempty"`
+	NotAfter   *int64  `json:"notAfter,omitempty"`
+	NotBefore  *int64  `json:"notBefore,omitempty"`
+	Thumbprint *string `json:"thumbprint,omitempty"`
+}
+
+type ProvisionedIdentity struct {
+	Details      map[string]interface{} `json:"details,omitempty"`
+	DisplayName  *string                `json:"displayName,omitempty"`
+	ID           *string                `json:"id,omitempty"`
+	IdentityType *string                `json:"identityType,omitempty"`
+}
+
+type ProvisioningErrorInfo struct {
+	AdditionalDetails *string                          `json:"additionalDetails,omitempty"`
+	ErrorCategory     *ProvisioningStatusErrorCategory `json:"errorCategory,omitempty"`
+	ErrorCode         *string                          `json:"errorCode,omitempty"`
+	Reason            *string                          `json:"reason,omitempty"`
+	RecommendedAction *string                          `json:"recommendedAction,omitempty"`
+}
+
+type ProvisioningInitiator struct {
+	DisplayName   *string        `json:"displayName,omitempty"`
+	ID            *string        `json:"id,omitempty"`
+	InitiatorType *InitiatorType `json:"initiatorType,omitempty"`
+}
+
+type ProvisioningObjectSummary struct {
+	ActivityDateTime       *time.Time                    `json:"activityDateTime,omitempty"`
+	ChangeId               *string                       `json:"changeId,omitempty"`
+	CycleId                *string                       `json:"cycleId,omitempty"`
+	DurationInMilliseconds *int                          `json:"durationInMilliseconds,omitempty"`
+	ID                     *string                       `json:"id,omitempty"`
+	InitiatedBy            *ProvisioningInitiator        `json:"initiatedBy,omitempty"`
+	JobId                  *string                       `json:"jobId,omitempty"`
+	ModifiedProperties     *[]ModifiedProperty           `json:"modifiedProperties,omitempty"`
+	ProvisioningAction     *ProvisioningAction           `json:"provisioningAction,omitempty"`
+	ProvisioningStatusInfo *ProvisioningStatusInfo       `json:"provisioningStatusInfo,omitempty"`
+	ProvisioningSteps      *[]ProvisioningStep           `json:"provisioningSteps,omitempty"`
+	ServicePrincipal       *ProvisioningServicePrincipal `json:"servicePrincipal,omitempty"`
+	SourceIdentity         *ProvisionedIdentity          `json:"sourceIdentity,omitempty"`
+	SourceSystem           *ProvisioningSystem           `json:"sourceSystem,omitempty"`
+	TargetIdentity         *ProvisionedIdentity          `json:"targetIdentity,omitempty"`
+	TargetSystem           *ProvisioningSystem           `json:"targetSystem,omitempty"`
+	TenantId               *string                       `json:"tenantId,omitempty"`
+}
+
+type ProvisioningServicePrincipal struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	ID          *string `json:"id,omitempty"`
+}
+
+type ProvisioningStatusInfo struct {
+	ErrorInformation *ProvisioningErrorInfo `json:"errorInformation,omitempty"`
+	Status           *ProvisioningResult    `json:"status,omitempty"`
+}
+
+type ProvisioningStep struct {
+	Description          *string                `json:"description,omitempty"`
+	Details              map[string]interface{} `json:"details,omitempty"`
+	Name                 *string                `json:"name,omitempty"`
+	ProvisioningStepType *ProvisioningStepType  `json:"provisioningStepType,omitempty"`
+	Status               *ProvisioningResult    `json:"status,omitempty"`
+}
+
+type ProvisioningSystem struct {
+	Details     map[string]interface{} `json:"details,omitempty"`
+	DisplayName *string                `json:"displayName,omitempty"`
+	ID          *string                `json:"id,omitempty"`
+}
+
 type PublicClient struct {
 	RedirectUris *[]string `json:"redirectUris,omitempty"`
 }
@@ -1545,6 +2378,11 @@ type Ref struct {
 	ObjectUri *string `json:"@odata.id,omitempty"`
 }
 
+type ReferencedObject struct {
+	ReferencedObjectName *string `json:"referencedObjectName,omitempty"`
+	ReferencedProperty   *string `json:"referencedProperty,omitempty"`
+}
+
 type RequestorSettings struct {
 	ScopeType         RequestorSettingsScopeType `json:"scopeType,omitempty"`
 	AcceptRequests    *bool                      `json:"acceptRequests,omitempty"`
@@ -1598,6 +2436,12 @@ type ScopedRoleMembership struct {
 	RoleMemberInfo       *Identity `json:"roleMemberInfo"`
 }
 
+// SegmentConfiguration describes the application segments of an application published through application proxy.
+type SegmentConfiguration struct {
+	ODataType           *odata.Type           `json:"@odata.type,omitempty"`
+	ApplicationSegments *[]ApplicationSegment `json:"applicationSegments,omitempty"`
+}
+
 // ServicePrincipal describes a Service Principal object.
 type ServicePrincipal struct {
 	DirectoryObject
@@ -1657,6 +2501,28 @@ type ServicePrincipalAppMetadata struct {
 	Data    *[]KeyValueObject `json:"data,omitempty"`
 }
 
+// ServicePrincipalSignInActivity describes the most recent sign-in activity for a service principal, as a client and as
+// a resource, for both delegated and application authentication flows.
+type ServicePrincipalSignInActivity struct {
+	Id                                              *string         `json:"id,omitempty"`
+	AppId                                           *string         `json:"appId,omitempty"`
+	ApplicationAuthenticationClientSignInActivity   *SignInActivity `json:"applicationAuthenticationClientSignInActivity,omitempty"`
+	ApplicationAuthenticationResourceSignInActivity *SignInActivity `json:"applicationAuthenticationResourceSignInActivity,omitempty"`
+	DelegatedClientSignInActivity                   *SignInActivity `json:"delegatedClientSignInActivity,omitempty"`
+	DelegatedResourceSignInActivity                 *SignInActivity `json:"delegatedResourceSignInActivity,omitempty"`
+	LastSignInActivity                              *SignInActivity `json:"lastSignInActivity,omitempty"`
+}
+
+type StringKeyAttributeMappingSourceValuePair struct {
+	Key   *string                 `json:"key,omitempty"`
+	Value *AttributeMappingSource `json:"value,omitempty"`
+}
+
+type StringKeyObjectValuePair struct {
+	Key   *string     `json:"key,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
 type SynchronizationSchedule struct {
 	Expiration *time.Time `json:"expiration,omitempty"`
 	Interval   *string    `json:"interval,omitempty"`
@@ -1664,9 +2530,26 @@ type SynchronizationSchedule struct {
 }
 
 type SynchronizationTaskExecution struct {
-	ActivityIdentifier *string `json:"activityIdentifier,omitempty"`
-	CountEntitled      *int64  `json:"countEntitled,omitempty"`
-	State              *string `json:"state,omitempty"`
+	ActivityIdentifier           *string                             `json:"activityIdentifier,omitempty"`
+	CountEntitled                *int64                              `json:"countEntitled,omitempty"`
+	CountEntitledForProvisioning *int64                              `json:"countEntitledForProvisioning,omitempty"`
+	CountEscrowed                *int64                              `json:"countEscrowed,omitempty"`
+	CountEscrowedRaw             *int64                              `json:"countEscrowedRaw,omitempty"`
+	CountExported                *int64                              `json:"countExported,omitempty"`
+	CountExports                 *int64                              `json:"countExports,omitempty"`
+	CountImported                *int64                              `json:"countImported,omitempty"`
+	CountImportedDeltas          *int64                              `json:"countImportedDeltas,omitempty"`
+	CountImportedReferenceDeltas *int64                              `json:"countImportedReferenceDeltas,omitempty"`
+	Error                        *SynchronizationError               `json:"error,omitempty"`
+	State                        *SynchronizationTaskExecutionResult `json:"state,omitempty"`
+	TimeBegan                    *time.Time                          `json:"timeBegan,omitempty"`
+	TimeEnded                    *time.Time                          `json:"timeEnded,omitempty"`
+}
+
+type SynchronizationError struct {
+	Code             *string `json:"code,omitempty"`
+	Message          *string `json:"message,omitempty"`
+	TenantActionable *bool   `json:"tenantActionable,omitempty"`
 }
 
 type SynchronizationProgress struct {
@@ -1677,11 +2560,12 @@ type SynchronizationProgress struct {
 }
 
 type SynchronizationQuarantine struct {
-	CurrentBegan *time.Time `json:"currentBegan,omitempty"`
-	NextAttempt  *time.Time `json:"nextAttempt,omitempty"`
-	Reason       *string    `json:"reason,omitempty"`
-	SeriesBegan  *time.Time `json:"seriesBegan,omitempty"`
-	SeriesCount  *int64     `json:"seriesCount,omitempty"`
+	CurrentBegan *time.Time            `json:"currentBegan,omitempty"`
+	Error        *SynchronizationError `json:"error,omitempty"`
+	NextAttempt  *time.Time            `json:"nextAttempt,omitempty"`
+	Reason       *QuarantineReason     `json:"reason,omitempty"`
+	SeriesBegan  *time.Time            `json:"seriesBegan,omitempty"`
+	SeriesCount  *int64                `json:"seriesCount,omitempty"`
 }
 
 type StringKeyLongValuePair struct {
@@ -1690,7 +2574,7 @@ type StringKeyLongValuePair struct {
 }
 
 type SynchronizationStatus struct {
-	Code                               *string                       `json:"code,omitempty"`
+	Code                               *SynchronizationStatusCode    `json:"code,omitempty"`
 	CountSuccssiveCompleteFailure      *int64                        `json:"countSuccessiveCompleteFailures,omitempty"`
 	EscrowsPruned                      *bool                         `json:"escrowsPruned,omitempty"`
 	LastExecution                      *SynchronizationTaskExecution `json:"lastExecution,omitempty"`
@@ -1701,6 +2585,7 @@ type SynchronizationStatus struct {
 	SteadyStateFirstAchievedTime       *time.Time                    `json:"steadyStateFirstAchievedTime,omitempty"`
 	SteadyStateLastAchievedTime        *time.Time                    `json:"steadyStateLastAchievedTime,omitempty"`
 	SynchronizedEntryCountByType       *[]StringKeyLongValuePair     `json:"synchronizedEntryCountByType,omitempty"`
+	TroubleshootingUrl                 *string                       `json:"troubleshootingUrl,omitempty"`
 }
 
 type SynchronizationJobRestartCriteria struct {
@@ -1748,11 +2633,132 @@ type SynchronizationJob struct {
 	TemplateId                 *string                       `json:"templateId,omitempty"`
 }
 
+type SynchronizationMetadataEntry struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type SynchronizationRule struct {
+	ContainerFilter     *ContainerFilter                `json:"containerFilter,omitempty"`
+	Editable            *bool                           `json:"editable,omitempty"`
+	GroupFilter         *GroupFilter                    `json:"groupFilter,omitempty"`
+	ID                  *string                         `json:"id,omitempty"`
+	Metadata            *[]SynchronizationMetadataEntry `json:"metadata,omitempty"`
+	Name                *string                         `json:"name,omitempty"`
+	ObjectMappings      *[]ObjectMapping                `json:"objectMappings,omitempty"`
+	Priority            *int                            `json:"priority,omitempty"`
+	SourceDirectoryName *string                         `json:"sourceDirectoryName,omitempty"`
+	TargetDirectoryName *string                         `json:"targetDirectoryName,omitempty"`
+}
+
+type SynchronizationSchema struct {
+	Directories          *[]DirectoryDefinition `json:"directories,omitempty"`
+	ID                   *string                `json:"id,omitempty"`
+	SynchronizationRules *[]SynchronizationRule `json:"synchronizationRules,omitempty"`
+	Version              *string                `json:"version,omitempty"`
+}
+
+// SetAttributeMapping adds an attribute mapping to the object mapping having the specified target object name, within
+// the synchronization rule having the specified ID. When ruleId is empty, the schema must contain exactly one
+// synchronization rule. Any existing attribute mapping for the same target attribute is replaced.
+func (s *SynchronizationSchema) SetAttributeMapping(ruleId, targetObjectName string, mapping AttributeMapping) error {
+	if mapping.TargetAttributeName == nil || *mapping.TargetAttributeName == "" {
+		return goerrors.New("attribute mapping has no target attribute name")
+	}
+
+	objectMapping, err := s.findObjectMapping(ruleId, targetObjectName)
+	if err != nil {
+		return err
+	}
+
+	if objectMapping.AttributeMappings == nil {
+		objectMapping.AttributeMappings = &[]AttributeMapping{}
+	}
+	for i, existing := range *objectMapping.AttributeMappings {
+		if existing.TargetAttributeName != nil && strings.EqualFold(*existing.TargetAttributeName, *mapping.TargetAttributeName) {
+			(*objectMapping.AttributeMappings)[i] = mapping
+			return nil
+		}
+	}
+	*objectMapping.AttributeMappings = append(*objectMapping.AttributeMappings, mapping)
+
+	return nil
+}
+
+// RemoveAttributeMapping removes the attribute mapping for the specified target attribute from the object mapping
+// having the specified target object name, within the synchronization rule having the specified ID. When ruleId is
+// empty, the schema must contain exactly one synchronization rule. Returns false if no such mapping was found.
+func (s *SynchronizationSchema) RemoveAttributeMapping(ruleId, targetObjectName, targetAttributeName string) (bool, error) {
+	objectMapping, err := s.findObjectMapping(ruleId, targetObjectName)
+	if err != nil {
+		return false, err
+	}
+
+	if objectMapping.AttributeMappings == nil {
+		return false, nil
+	}
+	for i, existing := range *objectMapping.AttributeMappings {
+		if existing.TargetAttributeName != nil && strings.EqualFold(*existing.TargetAttributeName, targetAttributeName) {
+			*objectMapping.AttributeMappings = append((*objectMapping.AttributeMappings)[:i], (*objectMapping.AttributeMappings)[i+1:]...)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *SynchronizationSchema) findObjectMapping(ruleId, targetObjectName string) (*ObjectMapping, error) {
+	if s.SynchronizationRules == nil || len(*s.SynchronizationRules) == 0 {
+		return nil, goerrors.New("synchronization schema has no synchronization rules")
+	}
+
+	var rule *SynchronizationRule
+	if ruleId == "" {
+		if len(*s.SynchronizationRules) > 1 {
+			return nil, fmt.Errorf("synchronization schema has %d synchronization rules, a rule ID must be specified", len(*s.SynchronizationRules))
+		}
+		rule = &(*s.SynchronizationRules)[0]
+	} else {
+		for i, r := range *s.SynchronizationRules {
+			if r.ID != nil && *r.ID == ruleId {
+				rule = &(*s.SynchronizationRules)[i]
+				break
+			}
+		}
+		if rule == nil {
+			return nil, fmt.Errorf("synchronization rule %q was not found", ruleId)
+		}
+	}
+
+	if rule.ObjectMappings != nil {
+		for i, m := range *rule.ObjectMappings {
+			if m.TargetObjectName != nil && strings.EqualFold(*m.TargetObjectName, targetObjectName) {
+				return &(*rule.ObjectMappings)[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("object mapping with target object %q was not found", targetObjectName)
+}
+
+type SynchronizationTemplate struct {
+	ApplicationId *string                         `json:"applicationId,omitempty"`
+	Default       *bool                           `json:"default,omitempty"`
+	Description   *string                         `json:"description,omitempty"`
+	Discoverable  *bool                           `json:"discoverable,omitempty"`
+	FactoryTag    *string                         `json:"factoryTag,omitempty"`
+	ID            *string                         `json:"id,omitempty"`
+	Metadata      *[]SynchronizationMetadataEntry `json:"metadata,omitempty"`
+	Schema        *SynchronizationSchema          `json:"schema,omitempty"`
+}
+
 type SignInActivity struct {
 	LastSignInDateTime                *time.Time `json:"lastSignInDateTime,omitempty"`
 	LastSignInRequestId               *string    `json:"lastSignInRequestId,omitempty"`
 	LastNonInteractiveSignInDateTime  *time.Time `json:"lastNonInteractiveSignInDateTime,omitempty"`
 	LastNonInteractiveSignInRequestId *string    `json:"lastNonInteractiveSignInRequestId,omitempty"`
+	LastSuccessfulSignInDateTime      *time.Time `json:"lastSuccessfulSignInDateTime,omitempty"`
+	LastSuccessfulSignInRequestId     *string    `json:"lastSuccessfulSignInRequestId,omitempty"`
 }
 
 type SignInFrequencySessionControl struct {
@@ -1796,6 +2802,12 @@ type SingleSignOnField struct {
 	Type            *string `json:"type,omitempty"`
 }
 
+type SoftwareOathAuthenticationMethod struct {
+	CreatedDateTime *time.Time `json:"createdDateTime,omitempty"`
+	ID              *string    `json:"id,omitempty"`
+	SecretKey       *string    `json:"secretKey,omitempty"`
+}
+
 type Status struct {
 	ErrorCode         *int32  `json:"errorCode,omitempty"`
 	FailureReason     *string `json:"failureReason,omitempty"`
@@ -1822,6 +2834,24 @@ type TermsOfUseAgreement struct {
 	File                              *TermsOfUseAgreementFile       `json:"file,omitempty"`
 }
 
+// TermsOfUseAgreementAcceptance records the acceptance or decline of a TermsOfUseAgreement by a user.
+type TermsOfUseAgreementAcceptance struct {
+	AgreementFileId    *string                   `json:"agreementFileId,omitempty"`
+	AgreementId        *string                   `json:"agreementId,omitempty"`
+	DeviceDisplayName  *string                   `json:"deviceDisplayName,omitempty"`
+	DeviceId           *string                   `json:"deviceId,omitempty"`
+	DeviceOSType       *string                   `json:"deviceOSType,omitempty"`
+	DeviceOSVersion    *string                   `json:"deviceOSVersion,omitempty"`
+	ExpirationDateTime *time.Time                `json:"expirationDateTime,omitempty"`
+	ID                 *string                   `json:"id,omitempty"`
+	RecordedDateTime   *time.Time                `json:"recordedDateTime,omitempty"`
+	State              *AgreementAcceptanceState `json:"state,omitempty"`
+	UserDisplayName    *string                   `json:"userDisplayName,omitempty"`
+	UserEmail          *string                   `json:"userEmail,omitempty"`
+	UserId             *string                   `json:"userId,omitempty"`
+	UserPrincipalName  *string                   `json:"userPrincipalName,omitempty"`
+}
+
 type TermsOfUseAgreementExpiration struct {
 	StartDateTime *time.Time `json:"startDateTime,omitempty"`
 	Frequency     *string    `json:"frequency,omitempty"`
@@ -1832,14 +2862,19 @@ type TermsOfUseAgreementFileData struct {
 	Data *[]byte `json:"data,omitempty"`
 }
 
+// TermsOfUseAgreementFile describes an agreement file, a localization of an agreement file, or a version of a
+// localization, which all share the same properties.
 type TermsOfUseAgreementFile struct {
-	ID             *string                      `json:"id,omitempty"`
-	DisplayName    *string                      `json:"displayName,omitempty"`
-	FileName       *string                      `json:"fileName,omitempty"`
-	Language       *string                      `json:"language,omitempty"`
-	IsDefault      *bool                        `json:"isDefault,omitempty"`
-	IsMajorVersion *bool                        `json:"isMajorVersion,omitempty"`
-	FileData       *TermsOfUseAgreementFileData `json:"fileData,omitempty"`
+	ID              *string                      `json:"id,omitempty"`
+	CreatedDateTime *time.Time                   `json:"createdDateTime,omitempty"`
+	DisplayName     *string                      `json:"displayName,omitempty"`
+	FileName        *string                      `json:"fileName,omitempty"`
+	Language        *string                      `json:"language,omitempty"`
+	IsDefault       *bool                        `json:"isDefault,omitempty"`
+	IsMajorVersion  *bool                        `json:"isMajorVersion,omitempty"`
+	FileData        *TermsOfUseAgreementFileData `json:"fileData,omitempty"`
+	Localizations   *[]TermsOfUseAgreementFile   `json:"localizations,omitempty"`
+	Versions        *[]TermsOfUseAgreementFile   `json:"versions,omitempty"`
 }
 
 type TemporaryAccessPassAuthenticationMethod struct {
@@ -1866,6 +2901,38 @@ type TokenIssuancePolicy struct {
 	IsOrganizationDefault *bool     `json:"isOrganizationDefault,omitempty"`
 }
 
+// TrustFrameworkKey describes a cryptographic key in a B2C trust framework key set. The private key fields (D, DP, DQ,
+// P, Q and QI) and the secret K are write-only: they are sent when uploading key material but are never populated in
+// responses.
+type TrustFrameworkKey struct {
+	D      *string                  `json:"d,omitempty"`
+	DP     *string                  `json:"dp,omitempty"`
+	DQ     *string                  `json:"dq,omitempty"`
+	E      *string                  `json:"e,omitempty"`
+	Exp    *int64                   `json:"exp,omitempty"`
+	K      *string                  `json:"k,omitempty"`
+	Kid    *string                  `json:"kid,omitempty"`
+	Kty    *TrustFrameworkKeyType   `json:"kty,omitempty"`
+	N      *string                  `json:"n,omitempty"`
+	Nbf    *int64                   `json:"nbf,omitempty"`
+	P      *string                  `json:"p,omitempty"`
+	Q      *string                  `json:"q,omitempty"`
+	QI     *string                  `json:"qi,omitempty"`
+	Status *TrustFrameworkKeyStatus `json:"status,omitempty"`
+	Use    *TrustFrameworkKeyUse    `json:"use,omitempty"`
+	X5C    *[]string                `json:"x5c,omitempty"`
+	X5T    *string                  `json:"x5t,omitempty"`
+}
+
+type TrustFrameworkKeySet struct {
+	ID   *string              `json:"id,omitempty"`
+	Keys *[]TrustFrameworkKey `json:"keys,omitempty"`
+}
+
+type TrustFrameworkPolicy struct {
+	ID *string `json:"id,omitempty"`
+}
+
 type UnifiedRoleAssignment struct {
 	DirectoryObject
 
@@ -1989,7 +3056,9 @@ type User struct {
 	EmployeeId                      *StringNullWhenEmpty     `json:"employeeId,omitempty"`
 	EmployeeOrgData                 *EmployeeOrgData         `json:"employeeOrgData,omitempty"`
 	EmployeeType                    *StringNullWhenEmpty     `json:"employeeType,omitempty"`
-	ExternalUserState               *string                  `json:"externalUserState,omitempty"`
+	Extensions                      *[]OpenTypeExtension     `json:"extensions,omitempty"`
+	ExternalUserState               *ExternalUserState       `json:"externalUserState,omitempty"`
+	ExternalUserStateChangeDateTime *time.Time               `json:"externalUserStateChangeDateTime,omitempty"`
 	FaxNumber                       *StringNullWhenEmpty     `json:"faxNumber,omitempty"`
 	GivenName                       *StringNullWhenEmpty     `json:"givenName,omitempty"`
 	ImAddresses                     *[]string                `json:"imAddresses,omitempty"`
@@ -2038,6 +3107,9 @@ type User struct {
 }
 
 func (u User) MarshalJSON() ([]byte, error) {
+	// Extensions is a read-only navigation property, open extensions are managed with OpenExtensionsClient
+	u.Extensions = nil
+
 	docs := make([][]byte, 0)
 	// Local type needed to avoid recursive MarshalJSON calls
 	type user User
@@ -2230,7 +3302,7 @@ type B2CUserFlow struct {
 	UserFlowType        *string  `json:"userFlowType,omitempty"`
 	UserFlowTypeVersion *float32 `json:"userFlowTypeVersion,omitempty"`
 	// The property that determines whether language customization is enabled within the B2C user flow. Language customization is not enabled by default for B2C user flows.
-	IsLanguageCustomizationEnabled *bool `json:"IsLanguageCustomizationEnabled,omitempty"`
+	IsLanguageCustomizationEnabled *bool `json:"isLanguageCustomizationEnabled,omitempty"`
 	// Indicates the default language of the b2cIdentityUserFlow that is used when no ui_locale tag is specified in the request. This field is RFC 5646 compliant.
 	DefaultLanguageTag *string `json:"defaultLanguageTag,omitempty"`
 }
@@ -2243,6 +3315,28 @@ type UserFlowAttribute struct {
 	DataType              *UserflowAttributeDataType `json:"dataType,omitempty"`
 }
 
+type UserAttributeValuesItem struct {
+	IsDefault *bool   `json:"isDefault,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Value     *string `json:"value,omitempty"`
+}
+
+type UserFlowApiConnectorConfiguration struct {
+	PostAttributeCollection *IdentityApiConnector `json:"postAttributeCollection,omitempty"`
+	PostFederationSignup    *IdentityApiConnector `json:"postFederationSignup,omitempty"`
+	PreTokenIssuance        *IdentityApiConnector `json:"preTokenIssuance,omitempty"`
+}
+
+type UserFlowLanguageConfiguration struct {
+	DisplayName *string `json:"displayName,omitempty"`
+	ID          *string `json:"id,omitempty"`
+	IsEnabled   *bool   `json:"isEnabled,omitempty"`
+}
+
+type UserFlowLanguagePage struct {
+	ID *string `json:"id,omitempty"`
+}
+
 type AttributeSet struct {
 	ID                  *string `json:"id,omitempty"`
 	Description         *string `json:"description,omitempty"`
@@ -0,0 +1,268 @@
+package msgraph
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+type testDirectoryObjectEntity struct {
+	DirectoryObject
+	Colour *string `json:"colour,omitempty"`
+}
+
+func TestDirectoryObjects_UnmarshalJSON(t *testing.T) {
+	respBody := []byte(`{"value": [
+		{"@odata.type": "#microsoft.graph.user", "id": "11111111-1111-1111-1111-111111111111", "userPrincipalName": "alice@example.com"},
+		{"@odata.type": "#microsoft.graph.group", "id": "22222222-2222-2222-2222-222222222222", "displayName": "Engineering"},
+		{"@odata.type": "#microsoft.graph.servicePrincipal", "id": "33333333-3333-3333-3333-333333333333", "appId": "44444444-4444-4444-4444-444444444444"},
+		{"@odata.type": "#microsoft.graph.device", "id": "55555555-5555-5555-5555-555555555555", "deviceId": "66666666-6666-6666-6666-666666666666"},
+		{"@odata.type": "#microsoft.graph.orgContact", "id": "77777777-7777-7777-7777-777777777777", "mail": "bob@example.net"},
+		{"@odata.type": "#microsoft.graph.application", "id": "88888888-8888-8888-8888-888888888888", "displayName": "My App"},
+		{"@odata.type": "#microsoft.graph.administrativeUnit", "id": "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa", "displayName": "Europe", "membershipType": "Dynamic"},
+		{"@odata.type": "#microsoft.graph.testWidget", "id": "99999999-9999-9999-9999-999999999999", "colour": "blue"}
+	]}`)
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		t.Fatalf("unmarshalDirectoryObjects(): %v", err)
+	}
+	if len(*objects) != 8 {
+		t.Fatalf("expected 8 objects, got %d", len(*objects))
+	}
+
+	if users := objects.Users(); len(users) != 1 || users[0].UserPrincipalName == nil || *users[0].UserPrincipalName != "alice@example.com" {
+		t.Fatalf("unexpected users: %#v", users)
+	}
+	if groups := objects.Groups(); len(groups) != 1 || groups[0].DisplayName == nil || *groups[0].DisplayName != "Engineering" {
+		t.Fatalf("unexpected groups: %#v", groups)
+	}
+	if servicePrincipals := objects.ServicePrincipals(); len(servicePrincipals) != 1 || servicePrincipals[0].AppId == nil {
+		t.Fatalf("unexpected service principals: %#v", servicePrincipals)
+	}
+	if devices := objects.Devices(); len(devices) != 1 || devices[0].DeviceId == nil {
+		t.Fatalf("unexpected devices: %#v", devices)
+	}
+	if orgContacts := objects.OrgContacts(); len(orgContacts) != 1 || orgContacts[0].Mail == nil {
+		t.Fatalf("unexpected org contacts: %#v", orgContacts)
+	}
+	if applications := objects.Applications(); len(applications) != 1 {
+		t.Fatalf("unexpected applications: %#v", applications)
+	}
+	administrativeUnits := objects.AdministrativeUnits()
+	if len(administrativeUnits) != 1 || administrativeUnits[0].DisplayName == nil || *administrativeUnits[0].DisplayName != "Europe" {
+		t.Fatalf("unexpected administrative units: %#v", administrativeUnits)
+	}
+	if administrativeUnits[0].ID == nil || *administrativeUnits[0].ID != "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa" || administrativeUnits[0].MembershipType == nil || *administrativeUnits[0].MembershipType != AdministrativeUnitMembershipTypeDynamic {
+		t.Fatalf("unexpected administrative unit: %#v", administrativeUnits[0])
+	}
+
+	unknown := objects.Unknown()
+	if len(unknown) != 1 {
+		t.Fatalf("expected 1 unknown object, got %d", len(unknown))
+	}
+	if colour, ok := unknown[0].AdditionalData["colour"]; !ok || colour != "blue" {
+		t.Fatalf("expected unknown object to retain additional data, got %#v", unknown[0].AdditionalData)
+	}
+
+	if ids := objects.IDs(); len(ids) != 8 || ids[0] != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("unexpected IDs: %v", ids)
+	}
+}
+
+func TestRegisterDirectoryObjectType(t *testing.T) {
+	const odataTypeTestWidget odata.Type = "#microsoft.graph.testWidget"
+	RegisterDirectoryObjectType(odataTypeTestWidget, func() DirectoryObjectEntity { return &testDirectoryObjectEntity{} })
+	defer func() {
+		directoryObjectTypesMutex.Lock()
+		delete(directoryObjectTypes, odataTypeTestWidget)
+		directoryObjectTypesMutex.Unlock()
+	}()
+
+	var objects DirectoryObjects
+	if err := json.Unmarshal([]byte(`[{"@odata.type": "#microsoft.graph.testWidget", "id": "99999999-9999-9999-9999-999999999999", "colour": "blue"}]`), &objects); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if len(objects) != 1 {
+		t.Fatalf("expected 1 object, got %d", len(objects))
+	}
+
+	widget, ok := objects[0].(*testDirectoryObjectEntity)
+	if !ok {
+		t.Fatalf("expected *testDirectoryObjectEntity, got %T", objects[0])
+	}
+	if widget.Colour == nil || *widget.Colour != "blue" {
+		t.Fatalf("unexpected colour: %v", widget.Colour)
+	}
+	if id := widget.ID(); id == nil || *id != "99999999-9999-9999-9999-999999999999" {
+		t.Fatalf("unexpected ID: %v", id)
+	}
+}
+
+func TestMarshalJSON_OmitsExtensions(t *testing.T) {
+	respBody := []byte(`{"id": "11111111-1111-1111-1111-111111111111", "displayName": "Test", "extensions": [{"@odata.type": "#microsoft.graph.openTypeExtension", "extensionName": "com.example.settings", "theme": "dark"}]}`)
+
+	var user User
+	if err := json.Unmarshal(respBody, &user); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	var group Group
+	if err := json.Unmarshal(respBody, &group); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if user.Extensions == nil || len(*user.Extensions) != 1 || group.Extensions == nil || len(*group.Extensions) != 1 {
+		t.Fatalf("expected extensions to be unmarshaled, got user: %v, group: %v", user.Extensions, group.Extensions)
+	}
+
+	for name, v := range map[string]interface{}{"User": user, "Group": group} {
+		body, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("json.Marshal(%s): %v", name, err)
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(body, &fields); err != nil {
+			t.Fatalf("json.Unmarshal(): %v", err)
+		}
+		if _, ok := fields["extensions"]; ok {
+			t.Errorf("expected extensions to be omitted when marshaling %s, got: %s", name, body)
+		}
+		if _, ok := fields["displayName"]; !ok {
+			t.Errorf("expected displayName when marshaling %s, got: %s", name, body)
+		}
+	}
+
+	if user.Extensions == nil || group.Extensions == nil {
+		t.Fatal("expected marshaling not to clear Extensions")
+	}
+}
+
+func TestSynchronizationSchema_SetAttributeMapping(t *testing.T) {
+	schema := SynchronizationSchema{
+		SynchronizationRules: &[]SynchronizationRule{
+			{
+				ID: utils.StringPtr("AD2SCIM"),
+				ObjectMappings: &[]ObjectMapping{
+					{
+						SourceObjectName: utils.StringPtr("Group"),
+						TargetObjectName: utils.StringPtr("Group"),
+					},
+					{
+						AttributeMappings: &[]AttributeMapping{
+							NewDirectAttributeMapping("userPrincipalName", "userName"),
+							NewDirectAttributeMapping("mail", "emails[type eq \"work\"].value"),
+						},
+						SourceObjectName: utils.StringPtr("User"),
+						TargetObjectName: utils.StringPtr("User"),
+					},
+				},
+			},
+		},
+	}
+
+	if err := schema.SetAttributeMapping("", "User", NewConstantAttributeMapping("Engineering", "department")); err != nil {
+		t.Fatalf("SetAttributeMapping(): %v", err)
+	}
+	if err := schema.SetAttributeMapping("AD2SCIM", "user", NewDirectAttributeMapping("mailNickname", "userName")); err != nil {
+		t.Fatalf("SetAttributeMapping(): %v", err)
+	}
+
+	mappings := *(*(*schema.SynchronizationRules)[0].ObjectMappings)[1].AttributeMappings
+	if len(mappings) != 3 {
+		t.Fatalf("expected 3 attribute mappings, got %d", len(mappings))
+	}
+	if *mappings[0].TargetAttributeName != "userName" || *mappings[0].Source.Name != "mailNickname" {
+		t.Fatalf("expected userName mapping to be replaced, got source %q", *mappings[0].Source.Name)
+	}
+	if *mappings[2].TargetAttributeName != "department" || *mappings[2].Source.Type != AttributeMappingSourceTypeConstant {
+		t.Fatalf("expected constant department mapping to be appended, got %q", *mappings[2].TargetAttributeName)
+	}
+
+	if removed, err := schema.RemoveAttributeMapping("", "User", "department"); err != nil || !removed {
+		t.Fatalf("RemoveAttributeMapping(): expected mapping to be removed, got removed=%t, err=%v", removed, err)
+	}
+	if removed, err := schema.RemoveAttributeMapping("", "User", "department"); err != nil || removed {
+		t.Fatalf("RemoveAttributeMapping(): expected no mapping to be removed, got removed=%t, err=%v", removed, err)
+	}
+
+	if err := schema.SetAttributeMapping("missing", "User", NewDirectAttributeMapping("mail", "userName")); err == nil {
+		t.Fatal("SetAttributeMapping(): expected error for unknown rule ID")
+	}
+	if err := schema.SetAttributeMapping("", "Contact", NewDirectAttributeMapping("mail", "userName")); err == nil {
+		t.Fatal("SetAttributeMapping(): expected error for unknown target object")
+	}
+	if err := schema.SetAttributeMapping("", "User", AttributeMapping{}); err == nil {
+		t.Fatal("SetAttributeMapping(): expected error for missing target attribute name")
+	}
+}
+
+func TestB2BManagementPolicy_SetDomainsPolicy(t *testing.T) {
+	policy := B2BManagementPolicy{
+		Definition: &[]string{`{"B2BManagementPolicy":{"InvitationsAllowedAndBlockedDomainsPolicy":{"AllowedDomains":[],"BlockedDomains":["example.net"]},"AutoRedeemPolicy":{"AdminConsentedForUsersIntoTenantIds":[]}}}`},
+	}
+
+	domainsPolicy, err := policy.DomainsPolicy()
+	if err != nil {
+		t.Fatalf("DomainsPolicy(): %v", err)
+	}
+	if domainsPolicy == nil || domainsPolicy.BlockedDomains == nil || len(*domainsPolicy.BlockedDomains) != 1 {
+		t.Fatalf("DomainsPolicy(): expected 1 blocked domain, got %#v", domainsPolicy)
+	}
+
+	if err := policy.SetDomainsPolicy(InvitationsAllowedAndBlockedDomainsPolicy{
+		AllowedDomains: &[]string{"example.com"},
+		BlockedDomains: &[]string{"example.net"},
+	}); err == nil {
+		t.Fatal("SetDomainsPolicy(): expected error when specifying both allowed and blocked domains")
+	}
+
+	if err := policy.SetDomainsPolicy(InvitationsAllowedAndBlockedDomainsPolicy{
+		AllowedDomains: &[]string{"example.com"},
+		BlockedDomains: &[]string{},
+	}); err != nil {
+		t.Fatalf("SetDomainsPolicy(): %v", err)
+	}
+
+	var definition map[string]map[string]json.RawMessage
+	if err := json.Unmarshal([]byte((*policy.Definition)[0]), &definition); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if _, ok := definition["B2BManagementPolicy"]["AutoRedeemPolicy"]; !ok {
+		t.Fatal("SetDomainsPolicy(): expected AutoRedeemPolicy to be retained")
+	}
+
+	domainsPolicy, err = policy.DomainsPolicy()
+	if err != nil {
+		t.Fatalf("DomainsPolicy(): %v", err)
+	}
+	if domainsPolicy.AllowedDomains == nil || len(*domainsPolicy.AllowedDomains) != 1 || (*domainsPolicy.AllowedDomains)[0] != "example.com" {
+		t.Fatalf("DomainsPolicy(): expected allowed domain example.com, got %#v", domainsPolicy.AllowedDomains)
+	}
+	if domainsPolicy.BlockedDomains == nil || len(*domainsPolicy.BlockedDomains) != 0 {
+		t.Fatalf("DomainsPolicy(): expected no blocked domains, got %#v", domainsPolicy.BlockedDomains)
+	}
+}
+
+func TestB2CUserFlow_IsLanguageCustomizationEnabled(t *testing.T) {
+	userFlow := B2CUserFlow{
+		ID:                             utils.StringPtr("B2C_1_signup"),
+		IsLanguageCustomizationEnabled: utils.BoolPtr(true),
+	}
+
+	body, err := json.Marshal(userFlow)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+	if expected := `{"id":"B2C_1_signup","isLanguageCustomizationEnabled":true}`; string(body) != expected {
+		t.Fatalf("expected %s, got %s", expected, body)
+	}
+
+	var decoded B2CUserFlow
+	if err = json.Unmarshal([]byte(`{"id":"B2C_1_signup","isLanguageCustomizationEnabled":true}`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+	if decoded.IsLanguageCustomizationEnabled == nil || !*decoded.IsLanguageCustomizationEnabled {
+		t.Fatalf("expected IsLanguageCustomizationEnabled to be true, got %v", decoded.IsLanguageCustomizationEnabled)
+	}
+}
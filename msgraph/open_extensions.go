@@ -0,0 +1,254 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type openExtensionsResourceType string
+
+const (
+	devicesOpenExtensionsResource      openExtensionsResourceType = "devices"
+	groupsOpenExtensionsResource       openExtensionsResourceType = "groups"
+	organizationOpenExtensionsResource openExtensionsResourceType = "organization"
+	usersOpenExtensionsResource        openExtensionsResourceType = "users"
+)
+
+// OpenTypeExtension is an open extension having untyped custom properties. Use FindOpenExtension() to convert to an
+// OpenExtension with typed custom properties, or the generic GetOpenExtension(), CreateOpenExtension() and
+// UpdateOpenExtension() functions to work with typed open extensions directly.
+type OpenTypeExtension = OpenExtension[map[string]interface{}]
+
+// OpenExtensionsClient performs operations on open extensions.
+type OpenExtensionsClient struct {
+	BaseClient   Client
+	resourceType openExtensionsResourceType
+}
+
+// NewDevicesOpenExtensionsClient returns a new OpenExtensionsClient for device open extensions
+func NewDevicesOpenExtensionsClient() *OpenExtensionsClient {
+	return &OpenExtensionsClient{
+		BaseClient:   NewClient(VersionBeta),
+		resourceType: devicesOpenExtensionsResource,
+	}
+}
+
+// NewGroupsOpenExtensionsClient returns a new OpenExtensionsClient for group open extensions
+func NewGroupsOpenExtensionsClient() *OpenExtensionsClient {
+	return &OpenExtensionsClient{
+		BaseClient:   NewClient(VersionBeta),
+		resourceType: groupsOpenExtensionsResource,
+	}
+}
+
+// NewOrganizationOpenExtensionsClient returns a new OpenExtensionsClient for organization open extensions
+func NewOrganizationOpenExtensionsClient() *OpenExtensionsClient {
+	return &OpenExtensionsClient{
+		BaseClient:   NewClient(VersionBeta),
+		resourceType: organizationOpenExtensionsResource,
+	}
+}
+
+// NewUsersOpenExtensionsClient returns a new OpenExtensionsClient for user open extensions
+func NewUsersOpenExtensionsClient() *OpenExtensionsClient {
+	return &OpenExtensionsClient{
+		BaseClient:   NewClient(VersionBeta),
+		resourceType: usersOpenExtensionsResource,
+	}
+}
+
+// List returns the open extensions for an object.
+// id is the object ID of the parent user, group, device or organization.
+func (c *OpenExtensionsClient) List(ctx context.Context, id string, query odata.Query) (*[]OpenTypeExtension, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/%s/%s/extensions", c.resourceType, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("OpenExtensionsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		OpenExtensions []OpenTypeExtension `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.OpenExtensions, status, nil
+}
+
+// Get retrieves an open extension for an object.
+// id is the object ID of the parent user, group, device or organization.
+func (c *OpenExtensionsClient) Get(ctx context.Context, id, extensionName string, query odata.Query) (*OpenTypeExtension, int, error) {
+	return GetOpenExtension[map[string]interface{}](ctx, c, id, extensionName, query)
+}
+
+// Create adds a new open extension to an object.
+// id is the object ID of the parent user, group, device or organization.
+func (c *OpenExtensionsClient) Create(ctx context.Context, id string, extension OpenTypeExtension) (*OpenTypeExtension, int, error) {
+	return CreateOpenExtension(ctx, c, id, extension)
+}
+
+// Update amends the custom properties of an existing open extension. Properties not specified are left unchanged.
+// id is the object ID of the parent user, group, device or organization.
+func (c *OpenExtensionsClient) Update(ctx context.Context, id string, extension OpenTypeExtension) (int, error) {
+	return UpdateOpenExtension(ctx, c, id, extension)
+}
+
+// Delete removes an open extension from an object.
+// id is the object ID of the parent user, group, device or organization.
+func (c *OpenExtensionsClient) Delete(ctx context.Context, id, extensionName string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/%s/%s/extensions/%s", c.resourceType, id, url.PathEscape(extensionName)),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("OpenExtensionsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// GetOpenExtension retrieves an open extension for an object, decoding its custom properties into a value of type T.
+// id is the object ID of the parent user, group, device or organization.
+func GetOpenExtension[T any](ctx context.Context, c *OpenExtensionsClient, id, extensionName string, query odata.Query) (*OpenExtension[T], int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/%s/%s/extensions/%s", c.resourceType, id, url.PathEscape(extensionName)),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("OpenExtensionsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var extension OpenExtension[T]
+	if err := json.Unmarshal(respBody, &extension); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &extension, status, nil
+}
+
+// CreateOpenExtension adds a new open extension having typed custom properties to an object.
+// id is the object ID of the parent user, group, device or organization.
+func CreateOpenExtension[T any](ctx context.Context, c *OpenExtensionsClient, id string, extension OpenExtension[T]) (*OpenExtension[T], int, error) {
+	var status int
+
+	if extension.ExtensionName == nil {
+		return nil, status, errors.New("cannot create open extension with nil ExtensionName")
+	}
+
+	body, err := json.Marshal(extension)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/%s/%s/extensions", c.resourceType, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("OpenExtensionsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newExtension OpenExtension[T]
+	if err := json.Unmarshal(respBody, &newExtension); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newExtension, status, nil
+}
+
+// UpdateOpenExtension amends the typed custom properties of an existing open extension.
+// id is the object ID of the parent user, group, device or organization.
+func UpdateOpenExtension[T any](ctx context.Context, c *OpenExtensionsClient, id string, extension OpenExtension[T]) (int, error) {
+	var status int
+
+	if extension.ExtensionName == nil {
+		return status, errors.New("cannot update open extension with nil ExtensionName")
+	}
+
+	body, err := json.Marshal(extension)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Patch(ctx, PatchHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/%s/%s/extensions/%s", c.resourceType, id, url.PathEscape(*extension.ExtensionName)),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("OpenExtensionsClient.BaseClient.Patch(): %v", err)
+	}
+
+	return status, nil
+}
+
+// FindOpenExtension returns the open extension with the specified name from a slice of open extensions, such as those
+// returned when retrieving a User or Group with `$expand=extensions`, decoding its custom properties into a value of
+// type T. Returns nil if no open extension with the specified name was found.
+func FindOpenExtension[T any](extensions *[]OpenTypeExtension, extensionName string) (*OpenExtension[T], error) {
+	if extensions == nil {
+		return nil, nil
+	}
+	for _, e := range *extensions {
+		if (e.ExtensionName != nil && *e.ExtensionName == extensionName) || (e.ID != nil && *e.ID == extensionName) {
+			data, err := json.Marshal(e)
+			if err != nil {
+				return nil, fmt.Errorf("json.Marshal(): %v", err)
+			}
+			var extension OpenExtension[T]
+			if err := json.Unmarshal(data, &extension); err != nil {
+				return nil, fmt.Errorf("json.Unmarshal(): %v", err)
+			}
+			extension.ID = e.ID
+			return &extension, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,138 @@
+package msgraph_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/test"
+	"github.com/manicminer/hamilton/internal/utils"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+type testOpenExtensionCostCenter struct {
+	CostCenter string `json:"costCenter,omitempty"`
+	Division   string `json:"division,omitempty"`
+}
+
+func TestOpenExtensionsClient(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	extensionName := fmt.Sprintf("com.hamilton.test%s", c.RandomString)
+
+	user := testUsersClient_Create(t, c, msgraph.User{
+		AccountEnabled:    utils.BoolPtr(true),
+		DisplayName:       utils.StringPtr("test-user-openextensions"),
+		MailNickname:      utils.StringPtr(fmt.Sprintf("test-user-openextensions-%s", c.RandomString)),
+		UserPrincipalName: utils.StringPtr(fmt.Sprintf("test-user-openextensions-%s@%s", c.RandomString, c.Connections["default"].DomainName)),
+		PasswordProfile: &msgraph.UserPasswordProfile{
+			Password: utils.StringPtr(fmt.Sprintf("IrPa55w0rd%s", c.RandomString)),
+		},
+	})
+
+	testOpenExtensionsClient_Create(t, c, c.UsersOpenExtensionsClient, *user.ID(), msgraph.OpenExtension[testOpenExtensionCostCenter]{
+		ExtensionName: utils.StringPtr(extensionName),
+		Value: &testOpenExtensionCostCenter{
+			CostCenter: "1234",
+		},
+	})
+	testOpenExtensionsClient_List(t, c, c.UsersOpenExtensionsClient, *user.ID())
+	testOpenExtensionsClient_Update(t, c, c.UsersOpenExtensionsClient, *user.ID(), msgraph.OpenExtension[testOpenExtensionCostCenter]{
+		ExtensionName: utils.StringPtr(extensionName),
+		Value: &testOpenExtensionCostCenter{
+			CostCenter: "5678",
+			Division:   "R&D",
+		},
+	})
+	extension := testOpenExtensionsClient_Get(t, c, c.UsersOpenExtensionsClient, *user.ID(), extensionName)
+	if extension.Value == nil || extension.Value.CostCenter != "5678" || extension.Value.Division != "R&D" {
+		t.Fatalf("OpenExtensionsClient.Get(): unexpected value: %+v", extension.Value)
+	}
+
+	expandedUser, status, err := c.UsersClient.Get(c.Context, *user.ID(), odata.Query{Expand: odata.Expand{Relationship: "extensions"}})
+	if err != nil {
+		t.Fatalf("UsersClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.Get(): invalid status: %d", status)
+	}
+	expandedExtension, err := msgraph.FindOpenExtension[testOpenExtensionCostCenter](expandedUser.Extensions, extensionName)
+	if err != nil {
+		t.Fatalf("FindOpenExtension(): %v", err)
+	}
+	if expandedExtension == nil || expandedExtension.Value == nil || expandedExtension.Value.CostCenter != "5678" {
+		t.Fatalf("FindOpenExtension(): unexpected extension: %+v", expandedExtension)
+	}
+
+	testOpenExtensionsClient_Delete(t, c, c.UsersOpenExtensionsClient, *user.ID(), extensionName)
+	testUsersClient_Delete(t, c, *user.ID())
+}
+
+func testOpenExtensionsClient_Create(t *testing.T, c *test.Test, client *msgraph.OpenExtensionsClient, id string, extension msgraph.OpenExtension[testOpenExtensionCostCenter]) (newExtension *msgraph.OpenExtension[testOpenExtensionCostCenter]) {
+	newExtension, status, err := msgraph.CreateOpenExtension(c.Context, client, id, extension)
+	if err != nil {
+		t.Fatalf("OpenExtensionsClient.Create(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("OpenExtensionsClient.Create(): invalid status: %d", status)
+	}
+	if newExtension == nil {
+		t.Fatal("OpenExtensionsClient.Create(): newExtension was nil")
+	}
+	if newExtension.ID == nil {
+		t.Fatal("OpenExtensionsClient.Create(): newExtension.ID was nil")
+	}
+	return
+}
+
+func testOpenExtensionsClient_Get(t *testing.T, c *test.Test, client *msgraph.OpenExtensionsClient, id, extensionName string) (extension *msgraph.OpenExtension[testOpenExtensionCostCenter]) {
+	extension, status, err := msgraph.GetOpenExtension[testOpenExtensionCostCenter](c.Context, client, id, extensionName, odata.Query{})
+	if err != nil {
+		t.Fatalf("OpenExtensionsClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("OpenExtensionsClient.Get(): invalid status: %d", status)
+	}
+	if extension == nil {
+		t.Fatal("OpenExtensionsClient.Get(): extension was nil")
+	}
+	return
+}
+
+func testOpenExtensionsClient_List(t *testing.T, c *test.Test, client *msgraph.OpenExtensionsClient, id string) (extensions *[]msgraph.OpenTypeExtension) {
+	extensions, status, err := client.List(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("OpenExtensionsClient.List(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("OpenExtensionsClient.List(): invalid status: %d", status)
+	}
+	if extensions == nil {
+		t.Fatal("OpenExtensionsClient.List(): extensions was nil")
+	}
+	if len(*extensions) == 0 {
+		t.Fatal("OpenExtensionsClient.List(): extensions was empty")
+	}
+	return
+}
+
+func testOpenExtensionsClient_Update(t *testing.T, c *test.Test, client *msgraph.OpenExtensionsClient, id string, extension msgraph.OpenExtension[testOpenExtensionCostCenter]) {
+	status, err := msgraph.UpdateOpenExtension(c.Context, client, id, extension)
+	if err != nil {
+		t.Fatalf("OpenExtensionsClient.Update(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("OpenExtensionsClient.Update(): invalid status: %d", status)
+	}
+}
+
+func testOpenExtensionsClient_Delete(t *testing.T, c *test.Test, client *msgraph.OpenExtensionsClient, id, extensionName string) {
+	status, err := client.Delete(c.Context, id, extensionName)
+	if err != nil {
+		t.Fatalf("OpenExtensionsClient.Delete(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("OpenExtensionsClient.Delete(): invalid status: %d", status)
+	}
+}
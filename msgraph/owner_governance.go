@@ -0,0 +1,335 @@
+package msgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// OwnerGovernanceReport lists applications and service principals having no owners, or having owners which are
+// disabled, deleted or guest users.
+type OwnerGovernanceReport struct {
+	GeneratedDateTime time.Time                    `json:"generatedDateTime"`
+	Objects           []OwnerGovernanceReportEntry `json:"objects"`
+}
+
+// OwnerGovernanceReportEntry describes the owner findings for a single application or service principal. An object is
+// orphaned when it has no owners other than disabled, deleted or guest users.
+type OwnerGovernanceReportEntry struct {
+	ObjectType             OwnerGovernanceObjectType `json:"objectType"`
+	ObjectId               string                    `json:"objectId"`
+	AppId                  string                    `json:"appId"`
+	DisplayName            string                    `json:"displayName"`
+	Findings               []OwnerGovernanceFinding  `json:"findings"`
+	Owners                 []OwnerGovernanceOwner    `json:"owners"`
+	Orphaned               bool                      `json:"orphaned"`
+	FallbackOwnersAssigned bool                      `json:"fallbackOwnersAssigned"`
+}
+
+// OwnerGovernanceOwner describes an owner of an application or service principal. Finding is empty for owners in good
+// standing.
+type OwnerGovernanceOwner struct {
+	ObjectId          string                 `json:"objectId"`
+	ObjectType        string                 `json:"objectType,omitempty"`
+	DisplayName       string                 `json:"displayName,omitempty"`
+	UserPrincipalName string                 `json:"userPrincipalName,omitempty"`
+	Finding           OwnerGovernanceFinding `json:"finding,omitempty"`
+}
+
+// OwnerGovernance audits the owners of applications and service principals, and assigns fallback owners to orphaned
+// objects. Applications are only audited when ApplicationsClient is set, and service principals only when
+// ServicePrincipalsClient is set. UsersClient is required to inspect user owners, and GroupsClient is required to
+// expand the members of a fallback owner group.
+type OwnerGovernance struct {
+	ApplicationsClient      *ApplicationsClient
+	GroupsClient            *GroupsClient
+	ServicePrincipalsClient *ServicePrincipalsClient
+	UsersClient             *UsersClient
+}
+
+// NewOwnerGovernance returns a new OwnerGovernance using the provided clients. Pass a nil ApplicationsClient or
+// ServicePrincipalsClient to skip auditing that object type.
+func NewOwnerGovernance(applicationsClient *ApplicationsClient, groupsClient *GroupsClient, servicePrincipalsClient *ServicePrincipalsClient, usersClient *UsersClient) *OwnerGovernance {
+	return &OwnerGovernance{
+		ApplicationsClient:      applicationsClient,
+		GroupsClient:            groupsClient,
+		ServicePrincipalsClient: servicePrincipalsClient,
+		UsersClient:             usersClient,
+	}
+}
+
+// Report pages through every Application and/or Service Principal, returning those with owner findings. Service
+// principals for managed identities and for applications published by Microsoft are excluded, since these do not have
+// owners in customer tenants.
+func (g *OwnerGovernance) Report(ctx context.Context) (*OwnerGovernanceReport, int, error) {
+	if g.UsersClient == nil {
+		return nil, 0, errors.New("cannot report on owners with nil UsersClient")
+	}
+
+	report := &OwnerGovernanceReport{
+		GeneratedDateTime: time.Now(),
+		Objects:           make([]OwnerGovernanceReportEntry, 0),
+	}
+	users := make(map[string]*User)
+
+	var status int
+	var err error
+
+	if g.ApplicationsClient != nil {
+		var applications *[]Application
+		applications, status, err = g.ApplicationsClient.List(ctx, odata.Query{
+			Select: []string{"id", "appId", "displayName"},
+		})
+		if err != nil {
+			return nil, status, fmt.Errorf("listing applications: %v", err)
+		}
+
+		for _, application := range *applications {
+			if application.ID() == nil {
+				continue
+			}
+
+			owners, status, err := g.ApplicationsClient.ListOwnerEntities(ctx, *application.ID(), odata.Query{})
+			if err != nil {
+				return nil, status, fmt.Errorf("listing owners for application with object ID %q: %v", *application.ID(), err)
+			}
+
+			entry, status, err := g.newEntry(ctx, users, OwnerGovernanceObjectTypeApplication, *application.ID(), application.AppId, application.DisplayName, owners)
+			if err != nil {
+				return nil, status, err
+			}
+			if entry != nil {
+				report.Objects = append(report.Objects, *entry)
+			}
+		}
+	}
+
+	if g.ServicePrincipalsClient != nil {
+		var servicePrincipals *[]ServicePrincipal
+		servicePrincipals, status, err = g.ServicePrincipalsClient.List(ctx, odata.Query{
+			Select: []string{"id", "appId", "appOwnerOrganizationId", "displayName", "servicePrincipalType"},
+		})
+		if err != nil {
+			return nil, status, fmt.Errorf("listing service principals: %v", err)
+		}
+
+		for _, servicePrincipal := range *servicePrincipals {
+			if servicePrincipal.ID() == nil || !isTenantOwnedServicePrincipal(servicePrincipal) {
+				continue
+			}
+
+			owners, status, err := g.ServicePrincipalsClient.ListOwnerEntities(ctx, *servicePrincipal.ID(), odata.Query{})
+			if err != nil {
+				return nil, status, fmt.Errorf("listing owners for service principal with object ID %q: %v", *servicePrincipal.ID(), err)
+			}
+
+			entry, status, err := g.newEntry(ctx, users, OwnerGovernanceObjectTypeServicePrincipal, *servicePrincipal.ID(), servicePrincipal.AppId, servicePrincipal.DisplayName, owners)
+			if err != nil {
+				return nil, status, err
+			}
+			if entry != nil {
+				report.Objects = append(report.Objects, *entry)
+			}
+		}
+	}
+
+	sort.SliceStable(report.Objects, func(i, j int) bool {
+		if report.Objects[i].Orphaned != report.Objects[j].Orphaned {
+			return report.Objects[i].Orphaned
+		}
+		return strings.ToLower(report.Objects[i].DisplayName) < strings.ToLower(report.Objects[j].DisplayName)
+	})
+
+	return report, status, nil
+}
+
+// AssignFallbackOwners adds the members of the specified fallback owner group to every orphaned object in the report,
+// and marks the corresponding report entries as having had fallback owners assigned. Owners of applications and service
+// principals must be users or service principals, so the group should not contain other types of members. The group
+// members are only retrieved when the report contains orphaned objects without fallback owners assigned.
+func (g *OwnerGovernance) AssignFallbackOwners(ctx context.Context, report *OwnerGovernanceReport, fallbackGroupId string) (int, error) {
+	if report == nil {
+		return 0, errors.New("cannot assign fallback owners with nil report")
+	}
+	if fallbackGroupId == "" {
+		return 0, errors.New("cannot assign fallback owners with empty fallback group ID")
+	}
+
+	var fallbackOwnerIds *[]string
+	var status int
+	var err error
+
+	for i, entry := range report.Objects {
+		if !entry.Orphaned || entry.FallbackOwnersAssigned {
+			continue
+		}
+
+		if fallbackOwnerIds == nil {
+			if g.GroupsClient == nil {
+				return status, errors.New("cannot expand fallback owner group with nil GroupsClient")
+			}
+			if fallbackOwnerIds, status, err = g.GroupsClient.ListMembers(ctx, fallbackGroupId); err != nil {
+				return status, fmt.Errorf("retrieving members of fallback owner group with object ID %q: %v", fallbackGroupId, err)
+			}
+			if fallbackOwnerIds == nil || len(*fallbackOwnerIds) == 0 {
+				return status, fmt.Errorf("fallback owner group with object ID %q has no members", fallbackGroupId)
+			}
+		}
+
+		switch entry.ObjectType {
+		case OwnerGovernanceObjectTypeApplication:
+			if g.ApplicationsClient == nil {
+				return status, errors.New("cannot assign fallback owners to applications with nil ApplicationsClient")
+			}
+			application := Application{
+				DirectoryObject: DirectoryObject{Id: &report.Objects[i].ObjectId},
+				Owners:          g.fallbackOwners(g.ApplicationsClient.BaseClient, *fallbackOwnerIds),
+			}
+			if status, err = g.ApplicationsClient.AddOwners(ctx, &application); err != nil {
+				return status, fmt.Errorf("adding fallback owners to application with object ID %q: %v", entry.ObjectId, err)
+			}
+
+		case OwnerGovernanceObjectTypeServicePrincipal:
+			if g.ServicePrincipalsClient == nil {
+				return status, errors.New("cannot assign fallback owners to service principals with nil ServicePrincipalsClient")
+			}
+			servicePrincipal := ServicePrincipal{
+				DirectoryObject: DirectoryObject{Id: &report.Objects[i].ObjectId},
+				Owners:          g.fallbackOwners(g.ServicePrincipalsClient.BaseClient, *fallbackOwnerIds),
+			}
+			if status, err = g.ServicePrincipalsClient.AddOwners(ctx, &servicePrincipal); err != nil {
+				return status, fmt.Errorf("adding fallback owners to service principal with object ID %q: %v", entry.ObjectId, err)
+			}
+		}
+
+		report.Objects[i].FallbackOwnersAssigned = true
+	}
+
+	return status, nil
+}
+
+func (g *OwnerGovernance) fallbackOwners(client Client, ownerIds []string) *Owners {
+	owners := make(Owners, 0, len(ownerIds))
+	for _, id := range ownerIds {
+		ownerId := id
+		owner := DirectoryObject{Id: &ownerId}
+		odataId := odata.Id(owner.Uri(client.Endpoint, client.ApiVersion))
+		owner.ODataId = &odataId
+		owners = append(owners, owner)
+	}
+	return &owners
+}
+
+// newEntry inspects the owners of an object, returning a report entry when there are findings. Users are cached so that
+// each user is retrieved at most once per report.
+func (g *OwnerGovernance) newEntry(ctx context.Context, users map[string]*User, objectType OwnerGovernanceObjectType, objectId string, appId, displayName *string, owners *DirectoryObjects) (*OwnerGovernanceReportEntry, int, error) {
+	var status int
+
+	entry := OwnerGovernanceReportEntry{
+		ObjectType: objectType,
+		ObjectId:   objectId,
+		Findings:   make([]OwnerGovernanceFinding, 0),
+		Owners:     make([]OwnerGovernanceOwner, 0),
+	}
+	if appId != nil {
+		entry.AppId = *appId
+	}
+	if displayName != nil {
+		entry.DisplayName = *displayName
+	}
+
+	if owners != nil {
+		for _, o := range *owners {
+			if o.ID() == nil {
+				continue
+			}
+
+			owner := OwnerGovernanceOwner{
+				ObjectId: *o.ID(),
+			}
+			switch v := o.(type) {
+			case *ServicePrincipal:
+				owner.ObjectType = "servicePrincipal"
+				if v.DisplayName != nil {
+					owner.DisplayName = *v.DisplayName
+				}
+
+			case *User:
+				owner.ObjectType = "user"
+				user, cached := users[owner.ObjectId]
+				if !cached {
+					var err error
+					user, status, err = g.UsersClient.Get(ctx, owner.ObjectId, odata.Query{
+						Select: []string{"id", "accountEnabled", "deletedDateTime", "displayName", "userPrincipalName", "userType"},
+					})
+					if err != nil && status != http.StatusNotFound {
+						return nil, status, fmt.Errorf("retrieving user with object ID %q: %v", owner.ObjectId, err)
+					}
+					users[owner.ObjectId] = user
+				}
+				owner.Finding = ownerGovernanceUserFinding(user)
+				if user != nil {
+					if user.DisplayName != nil {
+						owner.DisplayName = *user.DisplayName
+					}
+					if user.UserPrincipalName != nil {
+						owner.UserPrincipalName = *user.UserPrincipalName
+					}
+				}
+			}
+
+			entry.Owners = append(entry.Owners, owner)
+		}
+	}
+
+	entry.Findings, entry.Orphaned = ownerGovernanceFindings(entry.Owners)
+	if len(entry.Findings) == 0 {
+		return nil, status, nil
+	}
+
+	return &entry, status, nil
+}
+
+// ownerGovernanceUserFinding returns the finding for a user owner, or an empty finding for a user in good standing. A nil
+// user indicates that the user was not found.
+func ownerGovernanceUserFinding(user *User) OwnerGovernanceFinding {
+	switch {
+	case user == nil || user.DeletedDateTime != nil:
+		return OwnerGovernanceFindingDeletedOwner
+	case user.AccountEnabled != nil && !*user.AccountEnabled:
+		return OwnerGovernanceFindingDisabledOwner
+	case user.UserType != nil && strings.EqualFold(*user.UserType, "Guest"):
+		return OwnerGovernanceFindingGuestOwner
+	}
+	return ""
+}
+
+// ownerGovernanceFindings returns the distinct findings for a set of owners, and whether the object is orphaned,
+// i.e. has no owners in good standing.
+func ownerGovernanceFindings(owners []OwnerGovernanceOwner) (findings []OwnerGovernanceFinding, orphaned bool) {
+	findings = make([]OwnerGovernanceFinding, 0)
+	if len(owners) == 0 {
+		return append(findings, OwnerGovernanceFindingNoOwners), true
+	}
+
+	seen := make(map[OwnerGovernanceFinding]bool)
+	orphaned = true
+	for _, owner := range owners {
+		if owner.Finding == "" {
+			orphaned = false
+			continue
+		}
+		if !seen[owner.Finding] {
+			seen[owner.Finding] = true
+			findings = append(findings, owner.Finding)
+		}
+	}
+
+	return findings, orphaned
+}
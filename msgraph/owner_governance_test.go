@@ -0,0 +1,146 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+func TestOwnerGovernanceFindings(t *testing.T) {
+	enabled := &User{AccountEnabled: utils.BoolPtr(true), UserType: utils.StringPtr("Member")}
+	disabled := &User{AccountEnabled: utils.BoolPtr(false), UserType: utils.StringPtr("Member")}
+	guest := &User{AccountEnabled: utils.BoolPtr(true), UserType: utils.StringPtr("Guest")}
+
+	for _, c := range []struct {
+		user     *User
+		expected OwnerGovernanceFinding
+	}{
+		{enabled, ""},
+		{disabled, OwnerGovernanceFindingDisabledOwner},
+		{guest, OwnerGovernanceFindingGuestOwner},
+		{nil, OwnerGovernanceFindingDeletedOwner},
+	} {
+		if finding := ownerGovernanceUserFinding(c.user); finding != c.expected {
+			t.Errorf("expected finding %q, got %q", c.expected, finding)
+		}
+	}
+
+	findings, orphaned := ownerGovernanceFindings(nil)
+	if !orphaned || !reflect.DeepEqual(findings, []OwnerGovernanceFinding{OwnerGovernanceFindingNoOwners}) {
+		t.Errorf("expected object with no owners to be orphaned, got findings %v, orphaned %t", findings, orphaned)
+	}
+
+	findings, orphaned = ownerGovernanceFindings([]OwnerGovernanceOwner{
+		{ObjectId: "1", Finding: OwnerGovernanceFindingGuestOwner},
+		{ObjectId: "2", Finding: OwnerGovernanceFindingDisabledOwner},
+		{ObjectId: "3", Finding: OwnerGovernanceFindingGuestOwner},
+	})
+	if !orphaned || !reflect.DeepEqual(findings, []OwnerGovernanceFinding{OwnerGovernanceFindingGuestOwner, OwnerGovernanceFindingDisabledOwner}) {
+		t.Errorf("expected object with only guest and disabled owners to be orphaned, got findings %v, orphaned %t", findings, orphaned)
+	}
+
+	findings, orphaned = ownerGovernanceFindings([]OwnerGovernanceOwner{
+		{ObjectId: "1", Finding: OwnerGovernanceFindingDeletedOwner},
+		{ObjectId: "2"},
+	})
+	if orphaned || !reflect.DeepEqual(findings, []OwnerGovernanceFinding{OwnerGovernanceFindingDeletedOwner}) {
+		t.Errorf("expected object with an active owner not to be orphaned, got findings %v, orphaned %t", findings, orphaned)
+	}
+
+	if isTenantOwnedServicePrincipal(ServicePrincipal{AppOwnerOrganizationId: utils.StringPtr("F8CDEF31-A31E-4B4A-93E4-5F571E91255A")}) {
+		t.Errorf("expected Microsoft first-party service principal to be excluded")
+	}
+	if isTenantOwnedServicePrincipal(ServicePrincipal{ServicePrincipalType: utils.StringPtr("ManagedIdentity")}) {
+		t.Errorf("expected managed identity service principal to be excluded")
+	}
+	if !isTenantOwnedServicePrincipal(ServicePrincipal{AppOwnerOrganizationId: utils.StringPtr("11111111-1111-1111-1111-111111111111"), ServicePrincipalType: utils.StringPtr("Application")}) {
+		t.Errorf("expected customer service principal to be included")
+	}
+}
+
+func TestOwnerGovernance_AssignFallbackOwners(t *testing.T) {
+	var mu sync.Mutex
+	var memberRequests int
+	added := make(map[string][]string)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/beta/groups/fallback/members":
+			memberRequests++
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"value":[{"@odata.type":"#microsoft.graph.user","id":"user1"},{"@odata.type":"#microsoft.graph.servicePrincipal","id":"sp1"}]}`))
+
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/owners/$ref"):
+			var body struct {
+				ODataId string `json:"@odata.id"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decoding request body: %v", err)
+			}
+			object := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/beta/"), "/owners/$ref")
+			added[object] = append(added[object], body.ODataId[strings.LastIndex(body.ODataId, "/")+1:])
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	applicationsClient := NewApplicationsClient()
+	applicationsClient.BaseClient.Endpoint = ts.URL
+	applicationsClient.BaseClient.RetryableClient.RetryMax = 0
+	groupsClient := NewGroupsClient()
+	groupsClient.BaseClient.Endpoint = ts.URL
+	groupsClient.BaseClient.RetryableClient.RetryMax = 0
+	servicePrincipalsClient := NewServicePrincipalsClient()
+	servicePrincipalsClient.BaseClient.Endpoint = ts.URL
+	servicePrincipalsClient.BaseClient.RetryableClient.RetryMax = 0
+
+	governance := NewOwnerGovernance(applicationsClient, groupsClient, servicePrincipalsClient, nil)
+
+	report := &OwnerGovernanceReport{
+		Objects: []OwnerGovernanceReportEntry{
+			{ObjectType: OwnerGovernanceObjectTypeApplication, ObjectId: "app1", Orphaned: true},
+			{ObjectType: OwnerGovernanceObjectTypeApplication, ObjectId: "app2"},
+			{ObjectType: OwnerGovernanceObjectTypeServicePrincipal, ObjectId: "sp2", Orphaned: true},
+		},
+	}
+
+	if _, err := governance.AssignFallbackOwners(context.Background(), report, "fallback"); err != nil {
+		t.Fatalf("AssignFallbackOwners(): %v", err)
+	}
+
+	if memberRequests != 1 {
+		t.Errorf("expected fallback group members to be retrieved once, got %d requests", memberRequests)
+	}
+	expected := map[string][]string{
+		"applications/app1":     {"user1", "sp1"},
+		"servicePrincipals/sp2": {"user1", "sp1"},
+	}
+	if !reflect.DeepEqual(added, expected) {
+		t.Errorf("expected owners %v, got %v", expected, added)
+	}
+	if !report.Objects[0].FallbackOwnersAssigned || report.Objects[1].FallbackOwnersAssigned || !report.Objects[2].FallbackOwnersAssigned {
+		t.Errorf("unexpected FallbackOwnersAssigned values in report: %+v", report.Objects)
+	}
+
+	// a report with no remaining orphaned objects should not retrieve the group members again
+	if _, err := governance.AssignFallbackOwners(context.Background(), report, "fallback"); err != nil {
+		t.Fatalf("AssignFallbackOwners(): %v", err)
+	}
+	if memberRequests != 1 {
+		t.Errorf("expected fallback group members not to be retrieved again, got %d requests", memberRequests)
+	}
+}
@@ -0,0 +1,82 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// ProvisioningLogsClient performs operations on provisioning logs.
+type ProvisioningLogsClient struct {
+	BaseClient Client
+}
+
+// NewProvisioningLogsClient returns a new ProvisioningLogsClient.
+func NewProvisioningLogsClient() *ProvisioningLogsClient {
+	return &ProvisioningLogsClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// List returns a list of provisioning events, optionally queried using OData.
+// To retrieve the events for a single synchronization job, filter on jobid, e.g. `jobid eq 'dataBricks.abc123'`.
+func (c *ProvisioningLogsClient) List(ctx context.Context, query odata.Query) (*[]ProvisioningObjectSummary, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/auditLogs/provisioning",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ProvisioningLogsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		ProvisioningLogs []ProvisioningObjectSummary `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.ProvisioningLogs, status, nil
+}
+
+// Get retrieves a provisioning event.
+func (c *ProvisioningLogsClient) Get(ctx context.Context, id string, query odata.Query) (*ProvisioningObjectSummary, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/auditLogs/provisioning/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ProvisioningLogsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var provisioningLog ProvisioningObjectSummary
+	if err := json.Unmarshal(respBody, &provisioningLog); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &provisioningLog, status, nil
+}
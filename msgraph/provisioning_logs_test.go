@@ -0,0 +1,47 @@
+package msgraph_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/test"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestProvisioningLogsClient(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	provisioningLogs := testProvisioningLogsClient_List(t, c)
+	if len(*provisioningLogs) > 0 {
+		testProvisioningLogsClient_Get(t, c, *(*provisioningLogs)[0].ID)
+	}
+}
+
+func testProvisioningLogsClient_List(t *testing.T, c *test.Test) (provisioningLogs *[]msgraph.ProvisioningObjectSummary) {
+	provisioningLogs, status, err := c.ProvisioningLogsClient.List(c.Context, odata.Query{Top: 10})
+	if err != nil {
+		t.Fatalf("ProvisioningLogsClient.List(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ProvisioningLogsClient.List(): invalid status: %d", status)
+	}
+	if provisioningLogs == nil {
+		t.Fatal("ProvisioningLogsClient.List(): provisioningLogs was nil")
+	}
+	return
+}
+
+func testProvisioningLogsClient_Get(t *testing.T, c *test.Test, id string) (provisioningLog *msgraph.ProvisioningObjectSummary) {
+	provisioningLog, status, err := c.ProvisioningLogsClient.Get(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("ProvisioningLogsClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ProvisioningLogsClient.Get(): invalid status: %d", status)
+	}
+	if provisioningLog == nil {
+		t.Fatal("ProvisioningLogsClient.Get(): provisioningLog was nil")
+	}
+	return
+}
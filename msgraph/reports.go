@@ -191,3 +191,119 @@ func (c *ReportsClient) GetAuthenticationMethodsUsersRegisteredByMethod(ctx cont
 
 	return &userRegistrationMethodSummary, status, nil
 }
+
+// ListServicePrincipalSignInActivities returns the most recent sign-in activity for service principals in the tenant,
+// optionally queried using OData.
+func (c *ReportsClient) ListServicePrincipalSignInActivities(ctx context.Context, query odata.Query) (*[]ServicePrincipalSignInActivity, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/reports/servicePrincipalSignInActivities",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ReportsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		ServicePrincipalSignInActivities []ServicePrincipalSignInActivity `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.ServicePrincipalSignInActivities, status, nil
+}
+
+// GetServicePrincipalSignInActivity retrieves the sign-in activity report entry with the specified ID.
+func (c *ReportsClient) GetServicePrincipalSignInActivity(ctx context.Context, id string, query odata.Query) (*ServicePrincipalSignInActivity, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/reports/servicePrincipalSignInActivities/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ReportsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var servicePrincipalSignInActivity ServicePrincipalSignInActivity
+	if err := json.Unmarshal(respBody, &servicePrincipalSignInActivity); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &servicePrincipalSignInActivity, status, nil
+}
+
+// ListAppCredentialSignInActivities returns the most recent sign-in activity for application and service principal
+// credentials in the tenant, optionally queried using OData.
+func (c *ReportsClient) ListAppCredentialSignInActivities(ctx context.Context, query odata.Query) (*[]AppCredentialSignInActivity, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/reports/appCredentialSignInActivities",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ReportsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		AppCredentialSignInActivities []AppCredentialSignInActivity `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.AppCredentialSignInActivities, status, nil
+}
+
+// GetAppCredentialSignInActivity retrieves the credential sign-in activity report entry with the specified ID.
+func (c *ReportsClient) GetAppCredentialSignInActivity(ctx context.Context, id string, query odata.Query) (*AppCredentialSignInActivity, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/reports/appCredentialSignInActivities/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ReportsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var appCredentialSignInActivity AppCredentialSignInActivity
+	if err := json.Unmarshal(respBody, &appCredentialSignInActivity); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &appCredentialSignInActivity, status, nil
+}
@@ -18,6 +18,8 @@ func TestReports(t *testing.T) {
 	testReports_GetUserCredentialUsageDetails(t, c)
 	testReports_GetCredentialUsageSummary(t, c)
 	testReports_GetAuthenticationMethodsUsersRegisteredByMethod(t, c)
+	testReports_ListServicePrincipalSignInActivities(t, c)
+	testReports_ListAppCredentialSignInActivities(t, c)
 
 }
 
@@ -116,3 +118,35 @@ func testReports_GetAuthenticationMethodsUsersRegisteredByMethod(t *testing.T, c
 	}
 	return
 }
+
+func testReports_ListServicePrincipalSignInActivities(t *testing.T, c *test.Test) (report *[]msgraph.ServicePrincipalSignInActivity) {
+	report, status, err := c.ReportsClient.ListServicePrincipalSignInActivities(c.Context, odata.Query{Top: 10})
+	if status < 200 || status >= 300 {
+		t.Fatalf("ReportsClient.ListServicePrincipalSignInActivities(): invalid status: %d", status)
+	}
+
+	if err != nil {
+		t.Fatalf("ReportsClient.ListServicePrincipalSignInActivities(): %v", err)
+	}
+
+	if report == nil {
+		t.Fatal("ReportsClient.ListServicePrincipalSignInActivities():report was nil")
+	}
+	return
+}
+
+func testReports_ListAppCredentialSignInActivities(t *testing.T, c *test.Test) (report *[]msgraph.AppCredentialSignInActivity) {
+	report, status, err := c.ReportsClient.ListAppCredentialSignInActivities(c.Context, odata.Query{Top: 10})
+	if status < 200 || status >= 300 {
+		t.Fatalf("ReportsClient.ListAppCredentialSignInActivities(): invalid status: %d", status)
+	}
+
+	if err != nil {
+		t.Fatalf("ReportsClient.ListAppCredentialSignInActivities(): %v", err)
+	}
+
+	if report == nil {
+		t.Fatal("ReportsClient.ListAppCredentialSignInActivities():report was nil")
+	}
+	return
+}
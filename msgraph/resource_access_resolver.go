@@ -0,0 +1,318 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// ResolvedResourceAccess describes a single permission from a RequiredResourceAccess, resolved to the app role or
+// delegated permission scope published by the resource service principal. When a permission could not be resolved,
+// Value, DisplayName and Description are empty.
+type ResolvedResourceAccess struct {
+	ResourceAppId       string             `json:"resourceAppId"`
+	ResourceDisplayName string             `json:"resourceDisplayName,omitempty"`
+	ID                  string             `json:"id"`
+	Type                ResourceAccessType `json:"type"`
+	Value               string             `json:"value,omitempty"`
+	DisplayName         string             `json:"displayName,omitempty"`
+	Description         string             `json:"description,omitempty"`
+}
+
+// ResourceAccessResolver resolves the opaque IDs in RequiredResourceAccess to the app roles and delegated permission
+// scopes published by each resource, and vice versa. Resource service principals are retrieved at most once and the
+// results are cached for the lifetime of the resolver.
+//
+// Permissions for Microsoft Graph are first resolved from an offline snapshot of commonly used permissions, and are only
+// retrieved from the API when a permission is not found in the snapshot. When ServicePrincipalsClient is nil, the
+// resolver works entirely offline, and can resolve only the permissions contained in the snapshot. Permissions for any
+// other resource are then returned unresolved by Resolve(), and cause an error from ResolveIds().
+type ResourceAccessResolver struct {
+	ServicePrincipalsClient *ServicePrincipalsClient
+
+	mu        sync.Mutex
+	resources map[string]*resourceAccessPermissions
+	fetches   map[string]*resourceAccessFetch
+}
+
+// resourceAccessFetch tracks an in-flight retrieval of a resource service principal, so that concurrent lookups for the
+// same resource wait for a single request instead of each making their own.
+type resourceAccessFetch struct {
+	done     chan struct{}
+	resource *resourceAccessPermissions
+	status   int
+	err      error
+}
+
+type resourceAccessPermissions struct {
+	displayName string
+	byId        map[string]ResolvedResourceAccess
+	byValue     map[string]ResolvedResourceAccess
+	offline     bool
+}
+
+// NewResourceAccessResolver returns a ResourceAccessResolver which looks up resource service principals using the
+// provided client. Pass a nil client to work offline using only the Microsoft Graph snapshot.
+func NewResourceAccessResolver(client *ServicePrincipalsClient) *ResourceAccessResolver {
+	return &ResourceAccessResolver{
+		ServicePrincipalsClient: client,
+	}
+}
+
+// Resolve returns a ResolvedResourceAccess for each permission in the provided list of RequiredResourceAccess.
+// Permissions which cannot be found in the resource service principal are included without a Value, so that they are
+// still visible to a reviewer.
+func (r *ResourceAccessResolver) Resolve(ctx context.Context, requiredResourceAccess []RequiredResourceAccess) ([]ResolvedResourceAccess, int, error) {
+	var status int
+	ret := make([]ResolvedResourceAccess, 0)
+
+	for _, rra := range requiredResourceAccess {
+		if rra.ResourceAppId == nil || rra.ResourceAccess == nil {
+			continue
+		}
+
+		for _, ra := range *rra.ResourceAccess {
+			if ra.ID == nil {
+				continue
+			}
+
+			var resource *resourceAccessPermissions
+			var err error
+			resource, status, err = r.resource(ctx, *rra.ResourceAppId, func(p *resourceAccessPermissions) bool {
+				_, ok := p.byId[resourceAccessKey(ra.Type, *ra.ID)]
+				return ok
+			})
+			if err != nil {
+				return nil, status, err
+			}
+
+			resolved, ok := resource.byId[resourceAccessKey(ra.Type, *ra.ID)]
+			if !ok {
+				resolved = ResolvedResourceAccess{
+					ResourceAppId:       *rra.ResourceAppId,
+					ResourceDisplayName: resource.displayName,
+					ID:                  *ra.ID,
+					Type:                ra.Type,
+				}
+			}
+			ret = append(ret, resolved)
+		}
+	}
+
+	return ret, status, nil
+}
+
+// ResolveIds returns the ResourceAccess for each of the provided permission values (e.g. "User.Read.All") published by
+// the resource with the specified application ID. This is the reverse of Resolve(), for use when building the
+// RequiredResourceAccess for an Application. An error is returned if any value cannot be found.
+func (r *ResourceAccessResolver) ResolveIds(ctx context.Context, resourceAppId string, accessType ResourceAccessType, values []string) ([]ResourceAccess, int, error) {
+	resource, status, err := r.resource(ctx, resourceAppId, func(p *resourceAccessPermissions) bool {
+		for _, value := range values {
+			if _, ok := p.byValue[resourceAccessKey(accessType, value)]; !ok {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, status, err
+	}
+
+	ret := make([]ResourceAccess, 0, len(values))
+	for _, value := range values {
+		resolved, ok := resource.byValue[resourceAccessKey(accessType, value)]
+		if !ok {
+			return nil, status, fmt.Errorf("%s %q not found for resource with app ID %q", accessType, value, resourceAppId)
+		}
+		ret = append(ret, ResourceAccess{
+			ID:   &resolved.ID,
+			Type: resolved.Type,
+		})
+	}
+
+	return ret, status, nil
+}
+
+// NewRequiredResourceAccess returns a RequiredResourceAccess for the resource with the specified application ID, from
+// the values of the app roles and delegated permission scopes to be requested.
+func (r *ResourceAccessResolver) NewRequiredResourceAccess(ctx context.Context, resourceAppId string, roles, scopes []string) (*RequiredResourceAccess, int, error) {
+	resourceAccess := make([]ResourceAccess, 0, len(roles)+len(scopes))
+
+	resolvedRoles, status, err := r.ResolveIds(ctx, resourceAppId, ResourceAccessTypeRole, roles)
+	if err != nil {
+		return nil, status, err
+	}
+	resourceAccess = append(resourceAccess, resolvedRoles...)
+
+	resolvedScopes, status, err := r.ResolveIds(ctx, resourceAppId, ResourceAccessTypeScope, scopes)
+	if err != nil {
+		return nil, status, err
+	}
+	resourceAccess = append(resourceAccess, resolvedScopes...)
+
+	return &RequiredResourceAccess{
+		ResourceAppId:  &resourceAppId,
+		ResourceAccess: &resourceAccess,
+	}, status, nil
+}
+
+// resource returns the cached permissions for a resource, retrieving the resource service principal when it is not yet
+// cached, or when only the offline snapshot is cached and satisfied() reports that it does not contain the permissions
+// being resolved. The lock is not held while the service principal is being retrieved.
+func (r *ResourceAccessResolver) resource(ctx context.Context, resourceAppId string, satisfied func(*resourceAccessPermissions) bool) (*resourceAccessPermissions, int, error) {
+	key := strings.ToLower(resourceAppId)
+
+	r.mu.Lock()
+
+	if r.resources == nil {
+		r.resources = make(map[string]*resourceAccessPermissions)
+	}
+	if r.fetches == nil {
+		r.fetches = make(map[string]*resourceAccessFetch)
+	}
+
+	resource, ok := r.resources[key]
+	if !ok && strings.EqualFold(resourceAppId, environments.PublishedApis["MicrosoftGraph"]) {
+		resource = newMicrosoftGraphResourceAccessSnapshot()
+		r.resources[key] = resource
+		ok = true
+	}
+	if !ok && r.ServicePrincipalsClient == nil {
+		// Working offline, so there is nothing to resolve this resource's permissions against
+		resource = &resourceAccessPermissions{
+			byId:    make(map[string]ResolvedResourceAccess),
+			byValue: make(map[string]ResolvedResourceAccess),
+			offline: true,
+		}
+		r.resources[key] = resource
+		ok = true
+	}
+	if ok && (!resource.offline || satisfied(resource) || r.ServicePrincipalsClient == nil) {
+		r.mu.Unlock()
+		return resource, 0, nil
+	}
+
+	fetch, inFlight := r.fetches[key]
+	if !inFlight {
+		fetch = &resourceAccessFetch{
+			done: make(chan struct{}),
+		}
+		r.fetches[key] = fetch
+	}
+	r.mu.Unlock()
+
+	if inFlight {
+		select {
+		case <-fetch.done:
+			return fetch.resource, fetch.status, fetch.err
+		case <-ctx.Done():
+			return nil, 0, fmt.Errorf("waiting for service principal for resource with app ID %q: %v", resourceAppId, ctx.Err())
+		}
+	}
+
+	fetch.resource, fetch.status, fetch.err = r.fetchResource(ctx, resourceAppId)
+
+	r.mu.Lock()
+	if fetch.err == nil {
+		r.resources[key] = fetch.resource
+	}
+	delete(r.fetches, key)
+	r.mu.Unlock()
+	close(fetch.done)
+
+	return fetch.resource, fetch.status, fetch.err
+}
+
+// fetchResource retrieves the service principal for a resource and returns its published permissions.
+func (r *ResourceAccessResolver) fetchResource(ctx context.Context, resourceAppId string) (*resourceAccessPermissions, int, error) {
+	servicePrincipals, status, err := r.ServicePrincipalsClient.List(ctx, odata.Query{
+		Filter: fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(resourceAppId)),
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("retrieving service principal for resource with app ID %q: %v", resourceAppId, err)
+	}
+	if servicePrincipals == nil || len(*servicePrincipals) == 0 {
+		return nil, status, fmt.Errorf("no service principal found for resource with app ID %q", resourceAppId)
+	}
+
+	return newResourceAccessPermissions((*servicePrincipals)[0]), status, nil
+}
+
+func newResourceAccessPermissions(servicePrincipal ServicePrincipal) *resourceAccessPermissions {
+	ret := &resourceAccessPermissions{
+		byId:    make(map[string]ResolvedResourceAccess),
+		byValue: make(map[string]ResolvedResourceAccess),
+	}
+	if servicePrincipal.DisplayName != nil {
+		ret.displayName = *servicePrincipal.DisplayName
+	}
+
+	var resourceAppId string
+	if servicePrincipal.AppId != nil {
+		resourceAppId = *servicePrincipal.AppId
+	}
+
+	if servicePrincipal.AppRoles != nil {
+		for _, role := range *servicePrincipal.AppRoles {
+			if role.ID == nil {
+				continue
+			}
+			ret.add(ResolvedResourceAccess{
+				ResourceAppId:       resourceAppId,
+				ResourceDisplayName: ret.displayName,
+				ID:                  *role.ID,
+				Type:                ResourceAccessTypeRole,
+				Value:               stringValue(role.Value),
+				DisplayName:         stringValue(role.DisplayName),
+				Description:         stringValue(role.Description),
+			})
+		}
+	}
+
+	// The v1.0 API returns oauth2PermissionScopes, whereas the beta API returns publishedPermissionScopes
+	for _, scopes := range []*[]PermissionScope{servicePrincipal.OAuth2PermissionScopes, servicePrincipal.PublishedPermissionScopes} {
+		if scopes == nil {
+			continue
+		}
+		for _, scope := range *scopes {
+			if scope.ID == nil {
+				continue
+			}
+			ret.add(ResolvedResourceAccess{
+				ResourceAppId:       resourceAppId,
+				ResourceDisplayName: ret.displayName,
+				ID:                  *scope.ID,
+				Type:                ResourceAccessTypeScope,
+				Value:               stringValue(scope.Value),
+				DisplayName:         stringValue(scope.AdminConsentDisplayName),
+				Description:         stringValue(scope.AdminConsentDescription),
+			})
+		}
+	}
+
+	return ret
+}
+
+func (p *resourceAccessPermissions) add(resolved ResolvedResourceAccess) {
+	p.byId[resourceAccessKey(resolved.Type, resolved.ID)] = resolved
+	if resolved.Value != "" {
+		p.byValue[resourceAccessKey(resolved.Type, resolved.Value)] = resolved
+	}
+}
+
+// resourceAccessKey returns a cache key for a permission. App roles and delegated scopes are keyed separately, since
+// many resources publish a role and a scope with the same value.
+func resourceAccessKey(accessType ResourceAccessType, idOrValue string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", accessType, idOrValue))
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
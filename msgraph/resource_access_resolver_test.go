@@ -0,0 +1,166 @@
+package msgraph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+func TestResourceAccessResolver_Offline(t *testing.T) {
+	ctx := context.Background()
+	msGraphAppId := environments.PublishedApis["MicrosoftGraph"]
+	resolver := NewResourceAccessResolver(nil)
+
+	required, _, err := resolver.NewRequiredResourceAccess(ctx, msGraphAppId, []string{"User.Read.All"}, []string{"User.Read.All", "openid"})
+	if err != nil {
+		t.Fatalf("ResourceAccessResolver.NewRequiredResourceAccess(): %v", err)
+	}
+	if len(*required.ResourceAccess) != 3 {
+		t.Fatalf("expected 3 resource access entries, got %d", len(*required.ResourceAccess))
+	}
+	if id := *(*required.ResourceAccess)[0].ID; id != "df021288-bdef-4463-88db-98f22de89214" {
+		t.Errorf("unexpected ID for User.Read.All role: %q", id)
+	}
+	if id := *(*required.ResourceAccess)[1].ID; id != "a154be20-db9c-4678-8ab7-66f6cc099a59" {
+		t.Errorf("unexpected ID for User.Read.All scope: %q", id)
+	}
+
+	requiredResourceAccess := []RequiredResourceAccess{
+		*required,
+		{
+			ResourceAppId: utils.StringPtr(msGraphAppId),
+			ResourceAccess: &[]ResourceAccess{
+				{ID: utils.StringPtr("00000000-0000-0000-0000-000000000000"), Type: ResourceAccessTypeScope},
+			},
+		},
+	}
+	resolved, _, err := resolver.Resolve(ctx, requiredResourceAccess)
+	if err != nil {
+		t.Fatalf("ResourceAccessResolver.Resolve(): %v", err)
+	}
+	if len(resolved) != 4 {
+		t.Fatalf("expected 4 resolved permissions, got %d", len(resolved))
+	}
+	for i, expected := range []string{"User.Read.All", "User.Read.All", "openid", ""} {
+		if resolved[i].Value != expected {
+			t.Errorf("expected resolved permission %d to have value %q, got %q", i, expected, resolved[i].Value)
+		}
+		if resolved[i].ResourceDisplayName != "Microsoft Graph" {
+			t.Errorf("unexpected resource display name for resolved permission %d: %q", i, resolved[i].ResourceDisplayName)
+		}
+		if (resolved[i].Description == "") != (expected == "") {
+			t.Errorf("unexpected description for resolved permission %d: %q", i, resolved[i].Description)
+		}
+	}
+
+	resolved, _, err = resolver.Resolve(ctx, []RequiredResourceAccess{
+		{
+			ResourceAppId: utils.StringPtr("11111111-1111-1111-1111-111111111111"),
+			ResourceAccess: &[]ResourceAccess{
+				{ID: utils.StringPtr("22222222-2222-2222-2222-222222222222"), Type: ResourceAccessTypeRole},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ResourceAccessResolver.Resolve(): expected permission for unknown resource to be returned unresolved offline, got error: %v", err)
+	}
+	if len(resolved) != 1 || resolved[0].ID != "22222222-2222-2222-2222-222222222222" || resolved[0].Value != "" {
+		t.Errorf("unexpected resolved permissions for unknown resource offline: %+v", resolved)
+	}
+
+	if _, _, err = resolver.ResolveIds(ctx, msGraphAppId, ResourceAccessTypeRole, []string{"Not.A.Permission"}); err == nil {
+		t.Errorf("expected error resolving unknown permission offline")
+	}
+	if _, _, err = resolver.ResolveIds(ctx, "11111111-1111-1111-1111-111111111111", ResourceAccessTypeRole, []string{"Foo"}); err == nil {
+		t.Errorf("expected error resolving permission for unknown resource offline")
+	}
+}
+
+func TestResourceAccessResolver_ServicePrincipal(t *testing.T) {
+	resource := newResourceAccessPermissions(ServicePrincipal{
+		AppId:       utils.StringPtr("11111111-1111-1111-1111-111111111111"),
+		DisplayName: utils.StringPtr("My API"),
+		AppRoles: &[]AppRole{
+			{ID: utils.StringPtr("22222222-2222-2222-2222-222222222222"), Value: utils.StringPtr("Widgets.Read"), Description: utils.StringPtr("Read widgets")},
+		},
+		PublishedPermissionScopes: &[]PermissionScope{
+			{ID: utils.StringPtr("33333333-3333-3333-3333-333333333333"), Value: utils.StringPtr("Widgets.Read"), AdminConsentDescription: utils.StringPtr("Read widgets as user")},
+		},
+	})
+
+	role, ok := resource.byValue[resourceAccessKey(ResourceAccessTypeRole, "widgets.read")]
+	if !ok || role.ID != "22222222-2222-2222-2222-222222222222" || role.Description != "Read widgets" {
+		t.Errorf("unexpected role resolved by value: %+v", role)
+	}
+	scope, ok := resource.byId[resourceAccessKey(ResourceAccessTypeScope, "33333333-3333-3333-3333-333333333333")]
+	if !ok || scope.Value != "Widgets.Read" || scope.ResourceDisplayName != "My API" {
+		t.Errorf("unexpected scope resolved by ID: %+v", scope)
+	}
+}
+
+func TestResourceAccessResolver_ConcurrentFetch(t *testing.T) {
+	ctx := context.Background()
+	resolver := NewResourceAccessResolver(nil)
+
+	var requests int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		// The resolver must remain usable for cached resources while a service principal is being retrieved
+		done := make(chan error, 1)
+		go func() {
+			_, _, err := resolver.ResolveIds(ctx, environments.PublishedApis["MicrosoftGraph"], ResourceAccessTypeRole, []string{"User.Read.All"})
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("ResourceAccessResolver.ResolveIds(): %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Error("ResourceAccessResolver.ResolveIds(): blocked while a service principal was being retrieved")
+		}
+
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"value": [{"appId": "11111111-1111-1111-1111-111111111111", "displayName": "My API", "appRoles": [{"id": "22222222-2222-2222-2222-222222222222", "value": "Widgets.Read", "description": "Read widgets"}]}]}`))
+	}))
+	defer ts.Close()
+
+	client := NewServicePrincipalsClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+	resolver.ServicePrincipalsClient = client
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids, _, err := resolver.ResolveIds(ctx, "11111111-1111-1111-1111-111111111111", ResourceAccessTypeRole, []string{"Widgets.Read"})
+			if err != nil {
+				t.Errorf("ResourceAccessResolver.ResolveIds(): %v", err)
+				return
+			}
+			if len(ids) != 1 || *ids[0].ID != "22222222-2222-2222-2222-222222222222" {
+				t.Errorf("ResourceAccessResolver.ResolveIds(): unexpected result: %+v", ids)
+			}
+		}()
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("expected service principal to be retrieved once, got %d requests", n)
+	}
+}
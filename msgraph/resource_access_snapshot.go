@@ -0,0 +1,77 @@
+package msgraph
+
+import (
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// microsoftGraphResourceAccessSnapshot is an offline snapshot of commonly used app roles and delegated permission scopes
+// published by Microsoft Graph. Permission IDs are stable across all tenants and national clouds. Permissions not listed
+// here are retrieved from the Microsoft Graph service principal when a ServicePrincipalsClient is available.
+var microsoftGraphResourceAccessSnapshot = []ResolvedResourceAccess{
+	// App roles (application permissions)
+	{ID: "9a5d68dd-52b0-4cc2-bd40-abcf44ac3a30", Type: ResourceAccessTypeRole, Value: "Application.Read.All", DisplayName: "Read all applications", Description: "Allows the app to read all applications and service principals without a signed-in user."},
+	{ID: "1bfefb4e-e0b5-418b-a88f-73c46d2cc8e9", Type: ResourceAccessTypeRole, Value: "Application.ReadWrite.All", DisplayName: "Read and write all applications", Description: "Allows the app to create, read, update and delete applications and service principals without a signed-in user. Does not allow management of consent grants."},
+	{ID: "18a4783c-866b-4cc7-a460-3d5e5662c884", Type: ResourceAccessTypeRole, Value: "Application.ReadWrite.OwnedBy", DisplayName: "Manage apps that this app creates or owns", Description: "Allows the app to create other applications, and fully manage those applications (read, update, update application secrets and delete), without a signed-in user. It cannot update any apps that it is not an owner of."},
+	{ID: "06b708a9-e830-4db3-a914-8e69da51d44f", Type: ResourceAccessTypeRole, Value: "AppRoleAssignment.ReadWrite.All", DisplayName: "Manage app permission grants and app role assignments", Description: "Allows the app to manage permission grants for application permissions to any API (including Microsoft Graph) and application assignments for any app, without a signed-in user."},
+	{ID: "b0afded3-3588-46d8-8b3d-9842eff778da", Type: ResourceAccessTypeRole, Value: "AuditLog.Read.All", DisplayName: "Read all audit log data", Description: "Allows the app to read and query your audit log activities, without a signed-in user."},
+	{ID: "798ee544-9d2d-430c-a058-570e29e34338", Type: ResourceAccessTypeRole, Value: "Calendars.Read", DisplayName: "Read calendars in all mailboxes", Description: "Allows the app to read events of all calendars without a signed-in user."},
+	{ID: "7ab1d382-f21e-4acd-a863-ba3e13f7da61", Type: ResourceAccessTypeRole, Value: "Directory.Read.All", DisplayName: "Read directory data", Description: "Allows the app to read data in your organization's directory, such as users, groups and apps, without a signed-in user."},
+	{ID: "19dbc75e-c2e2-444c-a770-ec69d8559fc7", Type: ResourceAccessTypeRole, Value: "Directory.ReadWrite.All", DisplayName: "Read and write directory data", Description: "Allows the app to read and write data in your organization's directory, such as users, and groups, without a signed-in user. Does not allow user or group deletion."},
+	{ID: "dbb9058a-0e50-45d7-ae91-66909b5d4664", Type: ResourceAccessTypeRole, Value: "Domain.Read.All", DisplayName: "Read domains", Description: "Allows the app to read all domain properties without a signed-in user."},
+	{ID: "01d4889c-1287-42c6-ac1f-5d1e02578ef6", Type: ResourceAccessTypeRole, Value: "Files.Read.All", DisplayName: "Read files in all site collections", Description: "Allows the app to read all files in all site collections without a signed in user."},
+	{ID: "5b567255-7703-4780-807c-7be8301ae99b", Type: ResourceAccessTypeRole, Value: "Group.Read.All", DisplayName: "Read all groups", Description: "Allows the app to read group properties and memberships, and read conversations for all groups, without a signed-in user."},
+	{ID: "62a82d76-70ea-41e2-9197-370581804d09", Type: ResourceAccessTypeRole, Value: "Group.ReadWrite.All", DisplayName: "Read and write all groups", Description: "Allows the app to create groups, read all group properties and memberships, update group properties and memberships, and delete groups. Also allows the app to read and write conversations. All of these operations can be performed by the app without a signed-in user."},
+	{ID: "98830695-27a2-44f7-8c18-0c3ebc9698f6", Type: ResourceAccessTypeRole, Value: "GroupMember.Read.All", DisplayName: "Read all group memberships", Description: "Allows the app to read memberships and basic group properties for all groups without a signed-in user."},
+	{ID: "810c84a8-4a9e-49e6-bf7d-12d183f40d01", Type: ResourceAccessTypeRole, Value: "Mail.Read", DisplayName: "Read mail in all mailboxes", Description: "Allows the app to read mail in all mailboxes without a signed-in user."},
+	{ID: "b633e1c5-b582-4048-a93e-9f11b44c7e96", Type: ResourceAccessTypeRole, Value: "Mail.Send", DisplayName: "Send mail as any user", Description: "Allows the app to send mail as any user without a signed-in user."},
+	{ID: "246dd0d5-5bd0-4def-940b-0421030a5b68", Type: ResourceAccessTypeRole, Value: "Policy.Read.All", DisplayName: "Read your organization's policies", Description: "Allows the app to read all your organization's policies without a signed in user."},
+	{ID: "9e3f62cf-ca93-4989-b6ce-bf83c28f9fe8", Type: ResourceAccessTypeRole, Value: "RoleManagement.ReadWrite.Directory", DisplayName: "Read and write all directory RBAC settings", Description: "Allows the app to read and manage the role-based access control (RBAC) settings for your company's directory, without a signed-in user. This includes instantiating directory roles and managing directory role membership, and reading directory role templates, directory roles and memberships."},
+	{ID: "332a536c-c7ef-4017-ab91-336970924f0d", Type: ResourceAccessTypeRole, Value: "Sites.Read.All", DisplayName: "Read items in all site collections", Description: "Allows the app to read documents and list items in all site collections without a signed in user."},
+	{ID: "df021288-bdef-4463-88db-98f22de89214", Type: ResourceAccessTypeRole, Value: "User.Read.All", DisplayName: "Read all users' full profiles", Description: "Allows the app to read user profiles without a signed in user."},
+	{ID: "741f803b-c850-494e-b5df-cde7c675a1ca", Type: ResourceAccessTypeRole, Value: "User.ReadWrite.All", DisplayName: "Read and write all users' full profiles", Description: "Allows the app to read and update user profiles without a signed in user."},
+
+	// Delegated permission scopes
+	{ID: "c79f8feb-a9db-4090-85f9-90d820caa0eb", Type: ResourceAccessTypeScope, Value: "Application.Read.All", DisplayName: "Read applications", Description: "Allows the app to read applications and service principals on behalf of the signed-in user."},
+	{ID: "bdfbf15f-ee85-4955-8675-146e8e5296b5", Type: ResourceAccessTypeScope, Value: "Application.ReadWrite.All", DisplayName: "Read and write all applications", Description: "Allows the app to create, read, update and delete applications and service principals on behalf of the signed-in user. Does not allow management of consent grants."},
+	{ID: "e4c9e354-4dc5-45b8-9e7c-e1393b0b1a20", Type: ResourceAccessTypeScope, Value: "AuditLog.Read.All", DisplayName: "Read audit log data", Description: "Allows the app to read and query your audit log activities, on behalf of the signed-in user."},
+	{ID: "465a38f9-76ea-45b9-9f34-9e8b0d4b0b42", Type: ResourceAccessTypeScope, Value: "Calendars.Read", DisplayName: "Read user calendars", Description: "Allows the app to read events in user calendars."},
+	{ID: "0e263e50-5827-48a4-b97c-d940288653c7", Type: ResourceAccessTypeScope, Value: "Directory.AccessAsUser.All", DisplayName: "Access directory as the signed in user", Description: "Allows the app to have the same access to information in the directory as the signed-in user."},
+	{ID: "06da0dbc-49e2-44d2-8312-53f166ab848a", Type: ResourceAccessTypeScope, Value: "Directory.Read.All", DisplayName: "Read directory data", Description: "Allows the app to read data in your organization's directory, such as users, groups and apps."},
+	{ID: "c5366453-9fb0-48a5-a156-24f0c49a4b84", Type: ResourceAccessTypeScope, Value: "Directory.ReadWrite.All", DisplayName: "Read and write directory data", Description: "Allows the app to read and write data in your organization's directory, such as users, and groups. It does not allow the app to delete users or groups, or reset user passwords."},
+	{ID: "64a6cdd6-aab1-4aaf-94b8-3cc8405e90d0", Type: ResourceAccessTypeScope, Value: "email", DisplayName: "View users' email address", Description: "Allows the app to read your users' primary email address."},
+	{ID: "10465720-29dd-4523-a11a-6a75c743c9d9", Type: ResourceAccessTypeScope, Value: "Files.Read", DisplayName: "Read user files", Description: "Allows the app to read the signed-in user's files."},
+	{ID: "863451e7-0667-486c-a5d6-d135439485f0", Type: ResourceAccessTypeScope, Value: "Files.ReadWrite.All", DisplayName: "Have full access to all files user can access", Description: "Allows the app to read, create, update and delete all files the signed-in user can access."},
+	{ID: "5f8c59db-677d-491f-a6b8-5f174b11ec1d", Type: ResourceAccessTypeScope, Value: "Group.Read.All", DisplayName: "Read all groups", Description: "Allows the app to list groups, and to read their properties and all group memberships on behalf of the signed-in user. Also allows the app to read calendar, conversations, files, and other group content for all groups the signed-in user can access."},
+	{ID: "4e46008b-f24c-477d-8fff-7bb4ec7aafe0", Type: ResourceAccessTypeScope, Value: "Group.ReadWrite.All", DisplayName: "Read and write all groups", Description: "Allows the app to create groups and read all group properties and memberships on behalf of the signed-in user. Additionally allows group owners to manage their groups and allows group members to update group content."},
+	{ID: "bc024368-1153-4739-b217-4326f2e966d0", Type: ResourceAccessTypeScope, Value: "GroupMember.Read.All", DisplayName: "Read group memberships", Description: "Allows the app to list groups, read basic group properties and read membership of all groups the signed-in user has access to."},
+	{ID: "570282fd-fa5c-430d-a7fd-fc8dc98a9dca", Type: ResourceAccessTypeScope, Value: "Mail.Read", DisplayName: "Read user mail", Description: "Allows the app to read email in user mailboxes."},
+	{ID: "e383f46e-2787-4529-855e-0e479a3ffac0", Type: ResourceAccessTypeScope, Value: "Mail.Send", DisplayName: "Send mail as a user", Description: "Allows the app to send mail as users in the organization."},
+	{ID: "7427e0e9-2fba-42fe-b0c0-848c9e6a8182", Type: ResourceAccessTypeScope, Value: "offline_access", DisplayName: "Maintain access to data you have given it access to", Description: "Allows the app to see and update the data you gave it access to, even when users are not currently using the app. This does not give the app any additional permissions."},
+	{ID: "37f7f235-527c-4136-accd-4a02d197296e", Type: ResourceAccessTypeScope, Value: "openid", DisplayName: "Sign users in", Description: "Allows users to sign in to the app with their work or school accounts and allows the app to see basic user profile information."},
+	{ID: "14dad69e-099b-42c9-810b-d002981feec1", Type: ResourceAccessTypeScope, Value: "profile", DisplayName: "View users' basic profile", Description: "Allows the app to see your users' basic profile (e.g., name, picture, user name, email address)."},
+	{ID: "205e70e5-aba6-4c52-a976-6d2d46c48043", Type: ResourceAccessTypeScope, Value: "Sites.Read.All", DisplayName: "Read items in all site collections", Description: "Allows the app to read documents and list items in all site collections on behalf of the signed-in user."},
+	{ID: "e1fe6dd8-ba31-4d61-89e7-88639da4683d", Type: ResourceAccessTypeScope, Value: "User.Read", DisplayName: "Sign in and read user profile", Description: "Allows users to sign-in to the app, and allows the app to read the profile of signed-in users. It also allows the app to read basic company information of signed-in users."},
+	{ID: "a154be20-db9c-4678-8ab7-66f6cc099a59", Type: ResourceAccessTypeScope, Value: "User.Read.All", DisplayName: "Read all users' full profiles", Description: "Allows the app to read the full set of profile properties, reports, and managers of other users in your organization, on behalf of the signed-in user."},
+	{ID: "b340eb25-3456-403f-be2f-af7a0d370277", Type: ResourceAccessTypeScope, Value: "User.ReadBasic.All", DisplayName: "Read all users' basic profiles", Description: "Allows the app to read a basic set of profile properties of other users in your organization on behalf of the signed-in user. This includes display name, first and last name, email address and photo."},
+	{ID: "b4e74841-8e56-480b-be8b-910348b18b4c", Type: ResourceAccessTypeScope, Value: "User.ReadWrite", DisplayName: "Read and write access to user profile", Description: "Allows the app to read the signed-in user's full profile. It also allows the app to update the signed-in user's profile information on their behalf."},
+	{ID: "204e0828-b5ca-4ad8-b9f3-f32a958e7cc4", Type: ResourceAccessTypeScope, Value: "User.ReadWrite.All", DisplayName: "Read and write all users' full profiles", Description: "Allows the app to read and write the full set of profile properties, reports, and managers of other users in your organization, on behalf of the signed-in user."},
+}
+
+// newMicrosoftGraphResourceAccessSnapshot returns the offline snapshot of Microsoft Graph permissions for use by a
+// ResourceAccessResolver.
+func newMicrosoftGraphResourceAccessSnapshot() *resourceAccessPermissions {
+	ret := &resourceAccessPermissions{
+		displayName: "Microsoft Graph",
+		byId:        make(map[string]ResolvedResourceAccess),
+		byValue:     make(map[string]ResolvedResourceAccess),
+		offline:     true,
+	}
+
+	for _, resolved := range microsoftGraphResourceAccessSnapshot {
+		resolved.ResourceAppId = environments.PublishedApis["MicrosoftGraph"]
+		resolved.ResourceDisplayName = ret.displayName
+		ret.add(resolved)
+	}
+
+	return ret
+}
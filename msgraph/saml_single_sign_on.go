@@ -0,0 +1,136 @@
+package msgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+// SamlSingleSignOnConfiguration describes the SAML single sign-on settings for an enterprise application, such as one
+// instantiated from a gallery application template. Nil or empty fields are left unchanged.
+type SamlSingleSignOnConfiguration struct {
+	// IdentifierUris are the identifiers (entity IDs) of the service provider, set on the application.
+	IdentifierUris []string
+
+	// ReplyUrls are the assertion consumer service URLs of the service provider, set as the redirect URIs of the
+	// application and the reply URLs of the service principal.
+	ReplyUrls []string
+
+	// SignOnUrl is the URL used to initiate service provider initiated sign-on, set as the login URL of the service
+	// principal.
+	SignOnUrl *string
+
+	// LogoutUrl is the single logout URL of the service provider, set on the application.
+	LogoutUrl *string
+
+	// RelayState is the URL to which users are redirected following identity provider initiated sign-on.
+	RelayState *string
+
+	// NotificationEmailAddresses receive notifications when the token signing certificate is nearing expiry.
+	NotificationEmailAddresses []string
+
+	// ClaimsMappingPolicy, when specified, is an existing claims mapping policy to assign to the service principal.
+	ClaimsMappingPolicy *ClaimsMappingPolicy
+
+	// TokenSigningCertificate, when specified, causes a new self-signed token signing certificate to be created and set
+	// as the preferred token signing certificate. Only the DisplayName and EndDateTime fields are used.
+	TokenSigningCertificate *KeyCredential
+}
+
+// ConfigureSamlSingleSignOn configures SAML single sign-on for the enterprise application comprising the service
+// principal and application with the specified object IDs. The preferred single sign-on mode of the service principal
+// is set to SAML, and the remaining settings in config are applied to the service principal and application. When a
+// TokenSigningCertificate is specified, the new certificate is returned.
+func (c *ServicePrincipalsClient) ConfigureSamlSingleSignOn(ctx context.Context, servicePrincipalId, applicationId string, config SamlSingleSignOnConfiguration) (*KeyCredential, int, error) {
+	if servicePrincipalId == "" {
+		return nil, 0, errors.New("cannot configure SAML single sign-on for service principal with empty ID")
+	}
+	if applicationId == "" {
+		return nil, 0, errors.New("cannot configure SAML single sign-on for application with empty ID")
+	}
+
+	servicePrincipal := ServicePrincipal{
+		DirectoryObject: DirectoryObject{
+			Id: utils.StringPtr(servicePrincipalId),
+		},
+		PreferredSingleSignOnMode: NullableString(PreferredSingleSignOnModeSaml),
+	}
+	if len(config.ReplyUrls) > 0 {
+		servicePrincipal.ReplyUrls = &config.ReplyUrls
+	}
+	if config.SignOnUrl != nil {
+		servicePrincipal.LoginUrl = NullableString(StringNullWhenEmpty(*config.SignOnUrl))
+	}
+	if config.RelayState != nil {
+		servicePrincipal.SamlSingleSignOnSettings = &SamlSingleSignOnSettings{
+			RelayState: config.RelayState,
+		}
+	}
+	if len(config.NotificationEmailAddresses) > 0 {
+		servicePrincipal.NotificationEmailAddresses = &config.NotificationEmailAddresses
+	}
+
+	status, err := c.Update(ctx, servicePrincipal)
+	if err != nil {
+		return nil, status, fmt.Errorf("updating service principal: %v", err)
+	}
+
+	if len(config.IdentifierUris) > 0 || len(config.ReplyUrls) > 0 || config.LogoutUrl != nil {
+		application := Application{
+			DirectoryObject: DirectoryObject{
+				Id: utils.StringPtr(applicationId),
+			},
+		}
+		if len(config.IdentifierUris) > 0 {
+			application.IdentifierUris = &config.IdentifierUris
+		}
+		if len(config.ReplyUrls) > 0 || config.LogoutUrl != nil {
+			application.Web = &ApplicationWeb{}
+			if len(config.ReplyUrls) > 0 {
+				application.Web.RedirectUris = &config.ReplyUrls
+			}
+			if config.LogoutUrl != nil {
+				application.Web.LogoutUrl = NullableString(StringNullWhenEmpty(*config.LogoutUrl))
+			}
+		}
+
+		applicationsClient := &ApplicationsClient{BaseClient: c.BaseClient}
+		if status, err = applicationsClient.Update(ctx, application); err != nil {
+			return nil, status, fmt.Errorf("updating application: %v", err)
+		}
+	}
+
+	if config.ClaimsMappingPolicy != nil {
+		// copy the policy so that the caller's configuration is not modified
+		policy := *config.ClaimsMappingPolicy
+		if policy.ODataId == nil {
+			if policy.ID() == nil {
+				return nil, status, errors.New("cannot assign claims mapping policy with nil ID")
+			}
+			id := odata.Id(fmt.Sprintf("%s/%s/policies/claimsMappingPolicies/%s", c.BaseClient.Endpoint, c.BaseClient.ApiVersion, *policy.ID()))
+			policy.ODataId = &id
+		}
+		servicePrincipal.ClaimsMappingPolicies = &[]ClaimsMappingPolicy{policy}
+		if status, err = c.AssignClaimsMappingPolicy(ctx, &servicePrincipal); err != nil {
+			return nil, status, fmt.Errorf("assigning claims mapping policy: %v", err)
+		}
+	}
+
+	var signingCertificate *KeyCredential
+	if config.TokenSigningCertificate != nil {
+		if signingCertificate, status, err = c.AddTokenSigningCertificate(ctx, servicePrincipalId, *config.TokenSigningCertificate); err != nil {
+			return nil, status, fmt.Errorf("adding token signing certificate: %v", err)
+		}
+		if signingCertificate == nil || signingCertificate.Thumbprint == nil {
+			return nil, status, errors.New("adding token signing certificate: returned certificate has nil Thumbprint")
+		}
+		if status, err = c.SetPreferredTokenSigningKeyThumbprint(ctx, servicePrincipalId, *signingCertificate.Thumbprint); err != nil {
+			return signingCertificate, status, fmt.Errorf("setting preferred token signing certificate: %v", err)
+		}
+	}
+
+	return signingCertificate, status, nil
+}
@@ -0,0 +1,58 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+func TestServicePrincipalsClient_ConfigureSamlSingleSignOnClaimsMappingPolicy(t *testing.T) {
+	var assignedPolicy string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/beta/servicePrincipals/sp1":
+			w.WriteHeader(http.StatusNoContent)
+
+		case r.Method == http.MethodPost && r.URL.Path == "/beta/servicePrincipals/sp1/claimsMappingPolicies/$ref":
+			var body struct {
+				ODataId string `json:"@odata.id"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decoding request body: %v", err)
+			}
+			assignedPolicy = body.ODataId
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewServicePrincipalsClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	config := SamlSingleSignOnConfiguration{
+		ClaimsMappingPolicy: &ClaimsMappingPolicy{
+			DirectoryObject: DirectoryObject{Id: utils.StringPtr("policy1")},
+		},
+	}
+
+	if _, _, err := client.ConfigureSamlSingleSignOn(context.Background(), "sp1", "app1", config); err != nil {
+		t.Fatalf("ConfigureSamlSingleSignOn(): %v", err)
+	}
+
+	if expected := ts.URL + "/beta/policies/claimsMappingPolicies/policy1"; assignedPolicy != expected {
+		t.Errorf("expected claims mapping policy %q to be assigned, got %q", expected, assignedPolicy)
+	}
+	if config.ClaimsMappingPolicy.ODataId != nil {
+		t.Errorf("expected ClaimsMappingPolicy in config not to be modified, got ODataId %q", *config.ClaimsMappingPolicy.ODataId)
+	}
+}
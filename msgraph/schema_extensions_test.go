@@ -23,6 +23,11 @@ func (e *MyExtensionProperties) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, e2)
 }
 
+type MyTypedExtensionProperties struct {
+	Property1 string `json:"property1,omitempty"`
+	Property2 bool   `json:"property2,omitempty"`
+}
+
 func TestSchemaExtensionsClient(t *testing.T) {
 	c := test.NewTest(t)
 	defer c.CancelFunc()
@@ -130,6 +135,27 @@ func testSchemaExtensionsGroup(t *testing.T, c *test.Test, schema *msgraph.Schem
 		t.Fatalf("Unexpected value for Property2 returned: %+v", val)
 	}
 
+	// Update the group again using a typed msgraph.SchemaExtensionValue
+	group.SchemaExtensions = &[]msgraph.SchemaExtensionData{
+		msgraph.NewSchemaExtensionData(*schema.ID, &MyTypedExtensionProperties{
+			Property1: "my typed value",
+			Property2: true,
+		}),
+	}
+	testGroupsClient_Update(t, c, *group)
+
+	// Retrieve the typed value in a single request
+	group = testSchemaExtensionsGroup_GetWithExtensions(t, c, *group.ID(), []msgraph.SchemaExtensionData{
+		msgraph.NewSchemaExtensionData[MyTypedExtensionProperties](*schema.ID, nil),
+	})
+	typedValue, err := msgraph.GetSchemaExtensionValue[MyTypedExtensionProperties](group.SchemaExtensions, *schema.ID)
+	if err != nil {
+		t.Fatalf("GetSchemaExtensionValue(): %v", err)
+	}
+	if typedValue == nil || typedValue.Property1 != "my typed value" || !typedValue.Property2 {
+		t.Fatalf("Unexpected typed value returned: %+v", typedValue)
+	}
+
 	testGroupsClient_Delete(t, c, *group.ID())
 }
 
@@ -157,6 +183,23 @@ func testSchemaExtensionsGroup_Get(t *testing.T, c *test.Test, id string, schema
 	return
 }
 
+func testSchemaExtensionsGroup_GetWithExtensions(t *testing.T, c *test.Test, id string, schemaExtensions []msgraph.SchemaExtensionData) (group *msgraph.Group) {
+	group, status, err := c.GroupsClient.GetWithExtensions(c.Context, id, odata.Query{Select: []string{"displayName"}}, &schemaExtensions)
+	if err != nil {
+		t.Fatalf("GroupsClient.GetWithExtensions(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("GroupsClient.GetWithExtensions(): invalid status: %d", status)
+	}
+	if group == nil {
+		t.Fatal("GroupsClient.GetWithExtensions(): group was nil")
+	}
+	if group.DisplayName == nil {
+		t.Fatal("GroupsClient.GetWithExtensions(): group.DisplayName was nil")
+	}
+	return
+}
+
 func testSchemaExtensionsUser(t *testing.T, c *test.Test, schema *msgraph.SchemaExtension) {
 	// First create a user having schema extension data expressed using msgraph.SchemaExtensionMap
 	user := testUsersClient_Create(t, c, msgraph.User{
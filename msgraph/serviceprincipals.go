@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 )
@@ -216,6 +217,37 @@ func (c *ServicePrincipalsClient) ListOwners(ctx context.Context, id string) (*[
 	return &ret, status, nil
 }
 
+// ListOwnerEntities retrieves the owners of the specified Service Principal, optionally queried using OData.
+// id is the object ID of the service principal.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *ServicePrincipalsClient) ListOwnerEntities(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/owners", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ServicePrincipalsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
 // GetOwner retrieves a single owner for the specified Service Principal.
 // servicePrincipalId is the object ID of the service principal.
 // ownerId is the object ID of the owning object.
@@ -563,6 +595,281 @@ func (c *ServicePrincipalsClient) RemovePassword(ctx context.Context, servicePri
 	return status, nil
 }
 
+// CreatePasswordSingleSignOnCredentials creates password single sign-on credentials for a user or group, for a Service
+// Principal configured for password-based single sign-on. The ID of credentialSet is the object ID of the user or group.
+func (c *ServicePrincipalsClient) CreatePasswordSingleSignOnCredentials(ctx context.Context, servicePrincipalId string, credentialSet PasswordSingleSignOnCredentialSet) (*PasswordSingleSignOnCredentialSet, int, error) {
+	var status int
+
+	if credentialSet.ID == nil {
+		return nil, status, errors.New("cannot create password single sign-on credentials with nil ID")
+	}
+
+	body, err := json.Marshal(credentialSet)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/createPasswordSingleSignOnCredentials", servicePrincipalId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ServicePrincipalsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newCredentialSet PasswordSingleSignOnCredentialSet
+	if err := json.Unmarshal(respBody, &newCredentialSet); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newCredentialSet, status, nil
+}
+
+// GetPasswordSingleSignOnCredentials retrieves the password single sign-on credentials for a user or group, for a Service
+// Principal configured for password-based single sign-on. id is the object ID of the user or group.
+// Note that the values of credentials having a type of `password` are never returned.
+func (c *ServicePrincipalsClient) GetPasswordSingleSignOnCredentials(ctx context.Context, servicePrincipalId, id string) (*PasswordSingleSignOnCredentialSet, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		ID string `json:"id"`
+	}{
+		ID: id,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/getPasswordSingleSignOnCredentials", servicePrincipalId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ServicePrincipalsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var credentialSet PasswordSingleSignOnCredentialSet
+	if err := json.Unmarshal(respBody, &credentialSet); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &credentialSet, status, nil
+}
+
+// UpdatePasswordSingleSignOnCredentials updates the password single sign-on credentials for a user or group, for a
+// Service Principal configured for password-based single sign-on. The ID of credentialSet is the object ID of the user
+// or group. All credentials must be specified, including those that are not changing.
+func (c *ServicePrincipalsClient) UpdatePasswordSingleSignOnCredentials(ctx context.Context, servicePrincipalId string, credentialSet PasswordSingleSignOnCredentialSet) (int, error) {
+	var status int
+
+	if credentialSet.ID == nil {
+		return status, errors.New("cannot update password single sign-on credentials with nil ID")
+	}
+
+	body, err := json.Marshal(credentialSet)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/updatePasswordSingleSignOnCredentials", servicePrincipalId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ServicePrincipalsClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// DeletePasswordSingleSignOnCredentials deletes the password single sign-on credentials for a user or group, for a
+// Service Principal configured for password-based single sign-on. id is the object ID of the user or group.
+func (c *ServicePrincipalsClient) DeletePasswordSingleSignOnCredentials(ctx context.Context, servicePrincipalId, id string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		ID string `json:"id"`
+	}{
+		ID: id,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/deletePasswordSingleSignOnCredentials", servicePrincipalId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ServicePrincipalsClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// AddKey appends a new certificate credential to a Service Principal, without requiring any API permissions. proof must hold
+// an existing, valid, certificate credential for the service principal, which is used to sign the required proof-of-possession
+// token. Use NewKeyCredentialFromCertificate(), NewKeyCredentialFromPem() or NewKeyCredentialFromPkcs12() to prepare
+// keyCredential.
+func (c *ServicePrincipalsClient) AddKey(ctx context.Context, servicePrincipalId string, keyCredential KeyCredential, proof ProofOfPossessionSigner) (*KeyCredential, int, error) {
+	var status int
+
+	token, err := proof.Token(servicePrincipalId)
+	if err != nil {
+		return nil, status, err
+	}
+
+	body, err := json.Marshal(struct {
+		KeyCredential      KeyCredential       `json:"keyCredential"`
+		PasswordCredential *PasswordCredential `json:"passwordCredential"`
+		Proof              string              `json:"proof"`
+	}{
+		KeyCredential: keyCredential,
+		Proof:         token,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/addKey", servicePrincipalId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ServicePrincipalsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newKeyCredential KeyCredential
+	if err := json.Unmarshal(respBody, &newKeyCredential); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newKeyCredential, status, nil
+}
+
+// RemoveKey removes a certificate credential from a Service Principal, without requiring any API permissions. proof must hold
+// a valid certificate credential for the service principal, which is used to sign the required proof-of-possession token.
+func (c *ServicePrincipalsClient) RemoveKey(ctx context.Context, servicePrincipalId, keyId string, proof ProofOfPossessionSigner) (int, error) {
+	var status int
+
+	token, err := proof.Token(servicePrincipalId)
+	if err != nil {
+		return status, err
+	}
+
+	body, err := json.Marshal(struct {
+		KeyId string `json:"keyId"`
+		Proof string `json:"proof"`
+	}{
+		KeyId: keyId,
+		Proof: token,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/removeKey", servicePrincipalId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ServicePrincipalsClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// BeginKeyRotation starts a zero-downtime rotation of the certificate credential held by current to the certificate
+// held by next, by adding the new certificate using a proof signed with the current certificate. Both certificates
+// remain valid until CompleteKeyRotation() is called with the returned KeyRotation, allowing consumers of the
+// certificate to be updated in the meantime. When currentKeyId is empty, the existing credential is located by its
+// thumbprint, which requires permission to read the service principal.
+func (c *ServicePrincipalsClient) BeginKeyRotation(ctx context.Context, servicePrincipalId, currentKeyId string, current, next ProofOfPossessionSigner) (*KeyRotation, int, error) {
+	listKeys := func() (*[]KeyCredential, int, error) {
+		servicePrincipal, status, err := c.Get(ctx, servicePrincipalId, odata.Query{Select: []string{"keyCredentials"}})
+		if err != nil {
+			return nil, status, err
+		}
+		return servicePrincipal.KeyCredentials, status, nil
+	}
+	addKey := func(keyCredential KeyCredential, proof ProofOfPossessionSigner) (*KeyCredential, int, error) {
+		return c.AddKey(ctx, servicePrincipalId, keyCredential, proof)
+	}
+
+	return beginKeyRotation(currentKeyId, current, next, listKeys, addKey)
+}
+
+// CompleteKeyRotation completes a rotation started with BeginKeyRotation(), once the new certificate is in use, by
+// removing the previous certificate credential using a proof signed with the new certificate held by next.
+func (c *ServicePrincipalsClient) CompleteKeyRotation(ctx context.Context, servicePrincipalId string, rotation KeyRotation, next ProofOfPossessionSigner) (int, error) {
+	removeKey := func(keyId string, proof ProofOfPossessionSigner) (int, error) {
+		return c.RemoveKey(ctx, servicePrincipalId, keyId, proof)
+	}
+
+	return completeKeyRotation(rotation, next, removeKey)
+}
+
+// RotateKey replaces the certificate credential held by current with the certificate held by next, calling
+// BeginKeyRotation() and CompleteKeyRotation() in succession. There is no period during which both certificates are
+// valid, so anything still authenticating with the current certificate fails as soon as RotateKey returns; use
+// BeginKeyRotation() and CompleteKeyRotation() directly for a zero-downtime rotation. Returns the newly added
+// KeyCredential.
+func (c *ServicePrincipalsClient) RotateKey(ctx context.Context, servicePrincipalId, currentKeyId string, current, next ProofOfPossessionSigner) (*KeyCredential, int, error) {
+	rotation, status, err := c.BeginKeyRotation(ctx, servicePrincipalId, currentKeyId, current, next)
+	if err != nil {
+		return nil, status, err
+	}
+
+	status, err = c.CompleteKeyRotation(ctx, servicePrincipalId, *rotation, next)
+	if err != nil {
+		return rotation.NewKeyCredential, status, fmt.Errorf("new key credential was added but the existing key credential could not be removed: %v", err)
+	}
+
+	return rotation.NewKeyCredential, status, nil
+}
+
 // AddTokenSigningCertificate appends a new self signed certificate (keys and password) to a Service Principal.
 func (c *ServicePrincipalsClient) AddTokenSigningCertificate(ctx context.Context, servicePrincipalId string, keyCredential KeyCredential) (*KeyCredential, int, error) {
 	var status int
@@ -663,6 +970,37 @@ func (c *ServicePrincipalsClient) ListOwnedObjects(ctx context.Context, id strin
 	return &ret, status, nil
 }
 
+// ListOwnedObjectEntities retrieves the owned objects of the specified Service Principal, optionally queried using OData.
+// id is the object ID of the service principal.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *ServicePrincipalsClient) ListOwnedObjectEntities(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/ownedObjects", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ServicePrincipalsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
 // ListAppRoleAssignments retrieves a list of appRoleAssignment that users, groups, or client service principals have been granted for the given resource service principal.
 func (c *ServicePrincipalsClient) ListAppRoleAssignments(ctx context.Context, resourceId string, query odata.Query) (*[]AppRoleAssignment, int, error) {
 	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
@@ -879,3 +1217,27 @@ func (c *ServicePrincipalsClient) RemoveTokenIssuancePolicy(ctx context.Context,
 
 	return status, nil
 }
+
+// microsoftTenantIds are the tenants owning Microsoft first-party applications, whose service principals are
+// provisioned into customer tenants but are not managed by them.
+var microsoftTenantIds = []string{
+	"72f988bf-86f1-41af-91ab-2d7cd011db47", // Microsoft
+	"f8cdef31-a31e-4b4a-93e4-5f571e91255a", // Microsoft Services
+	"47df5bb7-e6bc-4256-afb0-dd8c8e3c1ce8", // Microsoft Accounts
+}
+
+// isTenantOwnedServicePrincipal returns whether a service principal is managed by the tenant, i.e. it is neither a
+// managed identity nor the service principal of a Microsoft first-party application.
+func isTenantOwnedServicePrincipal(servicePrincipal ServicePrincipal) bool {
+	if servicePrincipal.ServicePrincipalType != nil && strings.EqualFold(*servicePrincipal.ServicePrincipalType, "ManagedIdentity") {
+		return false
+	}
+	if servicePrincipal.AppOwnerOrganizationId != nil {
+		for _, tenantId := range microsoftTenantIds {
+			if strings.EqualFold(*servicePrincipal.AppOwnerOrganizationId, tenantId) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -194,6 +194,61 @@ func TestServicePrincipalsClient_AppRoleAssignments(t *testing.T) {
 
 }
 
+func TestServicePrincipalsClient_PasswordSingleSignOnCredentials(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	app := testApplicationsClient_Create(t, c, msgraph.Application{
+		DisplayName: utils.StringPtr(fmt.Sprintf("test-serviceprincipal-passwordSso-%s", c.RandomString)),
+		Web: &msgraph.ApplicationWeb{
+			HomePageUrl: msgraph.NullableString("https://app.example.com/login"),
+		},
+	})
+
+	sp := testServicePrincipalsClient_Create(t, c, msgraph.ServicePrincipal{
+		AccountEnabled:            utils.BoolPtr(true),
+		AppId:                     app.AppId,
+		DisplayName:               app.DisplayName,
+		LoginUrl:                  msgraph.NullableString("https://app.example.com/login"),
+		PreferredSingleSignOnMode: msgraph.NullableString(msgraph.PreferredSingleSignOnModePassword),
+	})
+
+	user := testUsersClient_Create(t, c, msgraph.User{
+		AccountEnabled:    utils.BoolPtr(true),
+		DisplayName:       utils.StringPtr("test-user-passwordSso"),
+		MailNickname:      utils.StringPtr(fmt.Sprintf("test-user-passwordSso-%s", c.RandomString)),
+		UserPrincipalName: utils.StringPtr(fmt.Sprintf("test-user-passwordSso-%s@%s", c.RandomString, c.Connections["default"].DomainName)),
+		PasswordProfile: &msgraph.UserPasswordProfile{
+			Password: utils.StringPtr(fmt.Sprintf("IrPa55w0rd%s", c.RandomString)),
+		},
+	})
+
+	credentialSet := msgraph.PasswordSingleSignOnCredentialSet{
+		ID: user.ID(),
+		Credentials: &[]msgraph.Credential{
+			{
+				FieldId: utils.StringPtr("param_username"),
+				Type:    utils.StringPtr(msgraph.CredentialTypeText),
+				Value:   utils.StringPtr(fmt.Sprintf("test-user-%s", c.RandomString)),
+			},
+			{
+				FieldId: utils.StringPtr("param_password"),
+				Type:    utils.StringPtr(msgraph.CredentialTypePassword),
+				Value:   utils.StringPtr(fmt.Sprintf("Pa55w0rd%s", c.RandomString)),
+			},
+		},
+	}
+	testServicePrincipalsClient_CreatePasswordSingleSignOnCredentials(t, c, *sp.ID(), credentialSet)
+	testServicePrincipalsClient_GetPasswordSingleSignOnCredentials(t, c, *sp.ID(), *user.ID())
+	(*credentialSet.Credentials)[1].Value = utils.StringPtr(fmt.Sprintf("Upd4tedPa55w0rd%s", c.RandomString))
+	testServicePrincipalsClient_UpdatePasswordSingleSignOnCredentials(t, c, *sp.ID(), credentialSet)
+	testServicePrincipalsClient_DeletePasswordSingleSignOnCredentials(t, c, *sp.ID(), *user.ID())
+
+	testUsersClient_Delete(t, c, *user.ID())
+	testServicePrincipalsClient_Delete(t, c, *sp.ID())
+	testApplicationsClient_Delete(t, c, *app.ID())
+}
+
 func testServicePrincipalsClient_Create(t *testing.T, c *test.Test, sp msgraph.ServicePrincipal) (servicePrincipal *msgraph.ServicePrincipal) {
 	servicePrincipal, status, err := c.ServicePrincipalsClient.Create(c.Context, sp)
 	if err != nil {
@@ -495,3 +550,54 @@ func testServicePrincipalsClient_RemoveAppRoleAssignment(t *testing.T, c *test.T
 		t.Fatalf("ServicePrincipalsClient.RemoveAppRoleAssignment(): invalid status: %d", status)
 	}
 }
+
+func testServicePrincipalsClient_CreatePasswordSingleSignOnCredentials(t *testing.T, c *test.Test, servicePrincipalId string, cs msgraph.PasswordSingleSignOnCredentialSet) (credentialSet *msgraph.PasswordSingleSignOnCredentialSet) {
+	credentialSet, status, err := c.ServicePrincipalsClient.CreatePasswordSingleSignOnCredentials(c.Context, servicePrincipalId, cs)
+	if err != nil {
+		t.Fatalf("ServicePrincipalsClient.CreatePasswordSingleSignOnCredentials(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ServicePrincipalsClient.CreatePasswordSingleSignOnCredentials(): invalid status: %d", status)
+	}
+	if credentialSet == nil {
+		t.Fatal("ServicePrincipalsClient.CreatePasswordSingleSignOnCredentials(): credentialSet was nil")
+	}
+	return
+}
+
+func testServicePrincipalsClient_GetPasswordSingleSignOnCredentials(t *testing.T, c *test.Test, servicePrincipalId, id string) (credentialSet *msgraph.PasswordSingleSignOnCredentialSet) {
+	credentialSet, status, err := c.ServicePrincipalsClient.GetPasswordSingleSignOnCredentials(c.Context, servicePrincipalId, id)
+	if err != nil {
+		t.Fatalf("ServicePrincipalsClient.GetPasswordSingleSignOnCredentials(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ServicePrincipalsClient.GetPasswordSingleSignOnCredentials(): invalid status: %d", status)
+	}
+	if credentialSet == nil {
+		t.Fatal("ServicePrincipalsClient.GetPasswordSingleSignOnCredentials(): credentialSet was nil")
+	}
+	if credentialSet.Credentials == nil || len(*credentialSet.Credentials) == 0 {
+		t.Fatal("ServicePrincipalsClient.GetPasswordSingleSignOnCredentials(): credentialSet.Credentials was empty")
+	}
+	return
+}
+
+func testServicePrincipalsClient_UpdatePasswordSingleSignOnCredentials(t *testing.T, c *test.Test, servicePrincipalId string, cs msgraph.PasswordSingleSignOnCredentialSet) {
+	status, err := c.ServicePrincipalsClient.UpdatePasswordSingleSignOnCredentials(c.Context, servicePrincipalId, cs)
+	if err != nil {
+		t.Fatalf("ServicePrincipalsClient.UpdatePasswordSingleSignOnCredentials(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ServicePrincipalsClient.UpdatePasswordSingleSignOnCredentials(): invalid status: %d", status)
+	}
+}
+
+func testServicePrincipalsClient_DeletePasswordSingleSignOnCredentials(t *testing.T, c *test.Test, servicePrincipalId, id string) {
+	status, err := c.ServicePrincipalsClient.DeletePasswordSingleSignOnCredentials(c.Context, servicePrincipalId, id)
+	if err != nil {
+		t.Fatalf("ServicePrincipalsClient.DeletePasswordSingleSignOnCredentials(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("ServicePrincipalsClient.DeletePasswordSingleSignOnCredentials(): invalid status: %d", status)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 )
@@ -32,6 +33,9 @@ func ConflictConsistencyFailureFunc(resp *http.Response, o *odata.OData) bool {
 	return o != nil && o.Error != nil && resp.StatusCode == http.StatusConflict
 }
 
+// SynchronizationJobStatusFunc receives the status of a SynchronizationJob each time it is polled by WaitForSteadyState
+type SynchronizationJobStatusFunc func(status SynchronizationStatus)
+
 // List returns a list of SynchronizationJobs
 func (c *SynchronizationJobClient) List(ctx context.Context, servicePrincipalId string) (*[]SynchronizationJob, int, error) {
 	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
@@ -324,3 +328,312 @@ func (c *SynchronizationJobClient) ValidateCredentials(ctx context.Context, id s
 
 	return status, nil
 }
+
+// ListTemplates returns the synchronization templates available for a service principal
+func (c *SynchronizationJobClient) ListTemplates(ctx context.Context, servicePrincipalId string) (*[]SynchronizationTemplate, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: ServicePrincipalDoesNotExistConsistency,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/synchronization/templates", servicePrincipalId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("SynchronizationJobClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		SynchronizationTemplates []SynchronizationTemplate `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.SynchronizationTemplates, status, nil
+}
+
+// GetTemplate retrieves a SynchronizationTemplate, including its default schema
+func (c *SynchronizationJobClient) GetTemplate(ctx context.Context, id string, servicePrincipalId string) (*SynchronizationTemplate, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: ServicePrincipalDoesNotExistConsistency,
+		OData: odata.Query{
+			Expand: odata.Expand{Relationship: "schema"},
+		},
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/synchronization/templates/%s", servicePrincipalId, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("SynchronizationJobClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var synchronizationTemplate SynchronizationTemplate
+	if err := json.Unmarshal(respBody, &synchronizationTemplate); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &synchronizationTemplate, status, nil
+}
+
+// GetSchema retrieves the SynchronizationSchema for a SynchronizationJob
+func (c *SynchronizationJobClient) GetSchema(ctx context.Context, id string, servicePrincipalId string) (*SynchronizationSchema, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: ServicePrincipalDoesNotExistConsistency,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s/schema", servicePrincipalId, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("SynchronizationJobClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var synchronizationSchema SynchronizationSchema
+	if err := json.Unmarshal(respBody, &synchronizationSchema); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &synchronizationSchema, status, nil
+}
+
+// UpdateSchema replaces the SynchronizationSchema for a SynchronizationJob
+func (c *SynchronizationJobClient) UpdateSchema(ctx context.Context, id string, synchronizationSchema SynchronizationSchema, servicePrincipalId string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(synchronizationSchema)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Put(ctx, PutHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: ServicePrincipalDoesNotExistConsistency,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s/schema", servicePrincipalId, id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("SynchronizationJobClient.BaseClient.Put(): %v", err)
+	}
+
+	return status, nil
+}
+
+// SetAttributeMapping adds or replaces a single attribute mapping in the SynchronizationSchema for a SynchronizationJob.
+// The mapping is made in the object mapping having the specified target object name (e.g. "User"), within the
+// synchronization rule having the specified ruleId. When ruleId is empty, the schema must contain exactly one rule.
+func (c *SynchronizationJobClient) SetAttributeMapping(ctx context.Context, id, ruleId, targetObjectName string, attributeMapping AttributeMapping, servicePrincipalId string) (int, error) {
+	synchronizationSchema, status, err := c.GetSchema(ctx, id, servicePrincipalId)
+	if err != nil {
+		return status, fmt.Errorf("SynchronizationJobClient.GetSchema(): %v", err)
+	}
+
+	if err = synchronizationSchema.SetAttributeMapping(ruleId, targetObjectName, attributeMapping); err != nil {
+		return status, fmt.Errorf("SynchronizationSchema.SetAttributeMapping(): %v", err)
+	}
+
+	return c.UpdateSchema(ctx, id, *synchronizationSchema, servicePrincipalId)
+}
+
+// RemoveAttributeMapping removes a single attribute mapping from the SynchronizationSchema for a SynchronizationJob.
+// See SetAttributeMapping for how the object mapping is located. No update is made if the mapping does not exist.
+func (c *SynchronizationJobClient) RemoveAttributeMapping(ctx context.Context, id, ruleId, targetObjectName, targetAttributeName string, servicePrincipalId string) (int, error) {
+	synchronizationSchema, status, err := c.GetSchema(ctx, id, servicePrincipalId)
+	if err != nil {
+		return status, fmt.Errorf("SynchronizationJobClient.GetSchema(): %v", err)
+	}
+
+	removed, err := synchronizationSchema.RemoveAttributeMapping(ruleId, targetObjectName, targetAttributeName)
+	if err != nil {
+		return status, fmt.Errorf("SynchronizationSchema.RemoveAttributeMapping(): %v", err)
+	}
+	if !removed {
+		return status, nil
+	}
+
+	return c.UpdateSchema(ctx, id, *synchronizationSchema, servicePrincipalId)
+}
+
+// ParseExpression parses an attribute mapping expression using the SynchronizationSchema for a SynchronizationJob,
+// optionally evaluating it against a test input object
+func (c *SynchronizationJobClient) ParseExpression(ctx context.Context, id string, parseExpressionRequest ParseExpressionRequest, servicePrincipalId string) (*ParseExpressionResponse, int, error) {
+	var status int
+
+	body, err := json.Marshal(parseExpressionRequest)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: ServicePrincipalDoesNotExistConsistency,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s/schema/parseExpression", servicePrincipalId, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("SynchronizationJobClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var parseExpressionResponse ParseExpressionResponse
+	if err := json.Unmarshal(respBody, &parseExpressionResponse); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &parseExpressionResponse, status, nil
+}
+
+// ListFilterOperators returns the operators supported in scoping filters for a SynchronizationJob
+func (c *SynchronizationJobClient) ListFilterOperators(ctx context.Context, id string, servicePrincipalId string) (*[]FilterOperatorSchema, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: ServicePrincipalDoesNotExistConsistency,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s/schema/filterOperators", servicePrincipalId, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("SynchronizationJobClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		FilterOperators []FilterOperatorSchema `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.FilterOperators, status, nil
+}
+
+// ListFunctions returns the functions supported in attribute mapping expressions for a SynchronizationJob
+func (c *SynchronizationJobClient) ListFunctions(ctx context.Context, id string, servicePrincipalId string) (*[]AttributeMappingFunctionSchema, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: ServicePrincipalDoesNotExistConsistency,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/servicePrincipals/%s/synchronization/jobs/%s/schema/functions", servicePrincipalId, id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("SynchronizationJobClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Functions []AttributeMappingFunctionSchema `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Functions, status, nil
+}
+
+// WaitForSteadyState polls a SynchronizationJob at the specified interval until it next reaches steady state, i.e. a
+// synchronization cycle has completed and there are no further changes to process. This is detected when the
+// SteadyStateLastAchievedTime of the job advances beyond the value observed at the first poll.
+// When statusFunc is not nil, it is called with the job status after each poll and can be used to report progress,
+// quarantine details, escrow counts and the result of the last execution.
+// An error is returned if the job is paused or enters quarantine, or if ctx is cancelled or reaches its deadline.
+func (c *SynchronizationJobClient) WaitForSteadyState(ctx context.Context, id string, servicePrincipalId string, interval time.Duration, statusFunc SynchronizationJobStatusFunc) (*SynchronizationJob, int, error) {
+	if interval <= 0 {
+		return nil, 0, fmt.Errorf("cannot wait for steady state with non-positive interval %s", interval)
+	}
+
+	var baseline *time.Time
+	first := true
+
+	for {
+		synchronizationJob, status, err := c.Get(ctx, id, servicePrincipalId)
+		if err != nil {
+			return nil, status, fmt.Errorf("SynchronizationJobClient.Get(): %v", err)
+		}
+		if synchronizationJob.Status == nil {
+			return nil, status, fmt.Errorf("SynchronizationJobClient.Get(): synchronization job returned with nil status")
+		}
+
+		jobStatus := *synchronizationJob.Status
+		if statusFunc != nil {
+			statusFunc(jobStatus)
+		}
+
+		if jobStatus.Code != nil {
+			switch *jobStatus.Code {
+			case SynchronizationStatusCodeQuarantine:
+				return synchronizationJob, status, fmt.Errorf("synchronization job %q was quarantined: %s", id, describeSynchronizationQuarantine(jobStatus.Quarantine))
+			case SynchronizationStatusCodePaused:
+				return synchronizationJob, status, fmt.Errorf("synchronization job %q is paused", id)
+			}
+		}
+
+		if achieved := jobStatus.SteadyStateLastAchievedTime; achieved != nil {
+			if !first && (baseline == nil || achieved.After(*baseline)) {
+				return synchronizationJob, status, nil
+			}
+		}
+		if first {
+			baseline = jobStatus.SteadyStateLastAchievedTime
+			first = false
+		}
+
+		select {
+		case <-ctx.Done():
+			return synchronizationJob, status, fmt.Errorf("waiting for steady state of synchronization job %q: %v", id, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+func describeSynchronizationQuarantine(quarantine *SynchronizationQuarantine) string {
+	if quarantine == nil {
+		return "no quarantine details were returned"
+	}
+
+	reason := "unknown reason"
+	if quarantine.Reason != nil {
+		reason = *quarantine.Reason
+	}
+	if quarantine.Error != nil && quarantine.Error.Message != nil {
+		return fmt.Sprintf("%s: %s", reason, *quarantine.Error.Message)
+	}
+	return reason
+}
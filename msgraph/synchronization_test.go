@@ -1,8 +1,14 @@
 package msgraph_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/manicminer/hamilton/internal/test"
 	"github.com/manicminer/hamilton/internal/utils"
@@ -41,6 +47,15 @@ func TestSynchronizationClient(t *testing.T) {
 	}, *app.ServicePrincipal.ID())
 
 	testSynchronizationJobClient_Get(t, c, *job.ID, *app.ServicePrincipal.ID())
+	testSynchronizationJobClient_ListTemplates(t, c, *app.ServicePrincipal.ID())
+	testSynchronizationJobClient_GetSchema(t, c, *job.ID, *app.ServicePrincipal.ID())
+	testSynchronizationJobClient_ListFilterOperators(t, c, *job.ID, *app.ServicePrincipal.ID())
+	testSynchronizationJobClient_ListFunctions(t, c, *job.ID, *app.ServicePrincipal.ID())
+	parsed := testSynchronizationJobClient_ParseExpression(t, c, *job.ID, msgraph.ParseExpressionRequest{
+		Expression: utils.StringPtr("Join(\" \", [givenName], [surname])"),
+	}, *app.ServicePrincipal.ID())
+	testSynchronizationJobClient_SetAttributeMapping(t, c, *job.ID, "", "User", msgraph.NewExpressionAttributeMapping(*parsed.ParsedExpression, "displayName"), *app.ServicePrincipal.ID())
+	testSynchronizationJobClient_RemoveAttributeMapping(t, c, *job.ID, "", "User", "displayName", *app.ServicePrincipal.ID())
 	testSynchronizationJobClient_Start(t, c, *job.ID, *app.ServicePrincipal.ID())
 	testSynchronizationJobClient_List(t, c, *app.ServicePrincipal.ID())
 	testSynchronizationJobClient_Pause(t, c, *job.ID, *app.ServicePrincipal.ID())
@@ -164,3 +179,217 @@ func testSynchronizationJobClient_Delete(t *testing.T, c *test.Test, jobId strin
 		t.Fatalf("SynchronizationJobClient.Delete(): invalid status: %d", status)
 	}
 }
+
+func testSynchronizationJobClient_ListTemplates(t *testing.T, c *test.Test, servicePrincipalId string) (synchronizationTemplates *[]msgraph.SynchronizationTemplate) {
+	synchronizationTemplates, status, err := c.SynchronizationJobClient.ListTemplates(c.Context, servicePrincipalId)
+	if err != nil {
+		t.Fatalf("SynchronizationJobClient.ListTemplates(): %v", err)
+	}
+
+	if status < 200 || status >= 300 {
+		t.Fatalf("SynchronizationJobClient.ListTemplates(): invalid status: %d", status)
+	}
+
+	if synchronizationTemplates == nil {
+		t.Fatalf("SynchronizationJobClient.ListTemplates(): synchronizationTemplates was nil")
+	}
+	return synchronizationTemplates
+}
+
+func testSynchronizationJobClient_GetSchema(t *testing.T, c *test.Test, jobId string, servicePrincipalId string) (synchronizationSchema *msgraph.SynchronizationSchema) {
+	synchronizationSchema, status, err := c.SynchronizationJobClient.GetSchema(c.Context, jobId, servicePrincipalId)
+	if err != nil {
+		t.Fatalf("SynchronizationJobClient.GetSchema(): %v", err)
+	}
+
+	if status < 200 || status >= 300 {
+		t.Fatalf("SynchronizationJobClient.GetSchema(): invalid status: %d", status)
+	}
+
+	if synchronizationSchema == nil {
+		t.Fatalf("SynchronizationJobClient.GetSchema(): synchronizationSchema was nil")
+	}
+	return synchronizationSchema
+}
+
+func testSynchronizationJobClient_ListFilterOperators(t *testing.T, c *test.Test, jobId string, servicePrincipalId string) (filterOperators *[]msgraph.FilterOperatorSchema) {
+	filterOperators, status, err := c.SynchronizationJobClient.ListFilterOperators(c.Context, jobId, servicePrincipalId)
+	if err != nil {
+		t.Fatalf("SynchronizationJobClient.ListFilterOperators(): %v", err)
+	}
+
+	if status < 200 || status >= 300 {
+		t.Fatalf("SynchronizationJobClient.ListFilterOperators(): invalid status: %d", status)
+	}
+
+	if filterOperators == nil {
+		t.Fatalf("SynchronizationJobClient.ListFilterOperators(): filterOperators was nil")
+	}
+	return filterOperators
+}
+
+func testSynchronizationJobClient_ListFunctions(t *testing.T, c *test.Test, jobId string, servicePrincipalId string) (functions *[]msgraph.AttributeMappingFunctionSchema) {
+	functions, status, err := c.SynchronizationJobClient.ListFunctions(c.Context, jobId, servicePrincipalId)
+	if err != nil {
+		t.Fatalf("SynchronizationJobClient.ListFunctions(): %v", err)
+	}
+
+	if status < 200 || status >= 300 {
+		t.Fatalf("SynchronizationJobClient.ListFunctions(): invalid status: %d", status)
+	}
+
+	if functions == nil {
+		t.Fatalf("SynchronizationJobClient.ListFunctions(): functions was nil")
+	}
+	return functions
+}
+
+func testSynchronizationJobClient_ParseExpression(t *testing.T, c *test.Test, jobId string, request msgraph.ParseExpressionRequest, servicePrincipalId string) (response *msgraph.ParseExpressionResponse) {
+	response, status, err := c.SynchronizationJobClient.ParseExpression(c.Context, jobId, request, servicePrincipalId)
+	if err != nil {
+		t.Fatalf("SynchronizationJobClient.ParseExpression(): %v", err)
+	}
+
+	if status < 200 || status >= 300 {
+		t.Fatalf("SynchronizationJobClient.ParseExpression(): invalid status: %d", status)
+	}
+
+	if response == nil {
+		t.Fatalf("SynchronizationJobClient.ParseExpression(): response was nil")
+	}
+
+	if response.ParsingSucceeded == nil || !*response.ParsingSucceeded || response.ParsedExpression == nil {
+		t.Fatalf("SynchronizationJobClient.ParseExpression(): expression was not parsed")
+	}
+	return response
+}
+
+func testSynchronizationJobClient_SetAttributeMapping(t *testing.T, c *test.Test, jobId, ruleId, targetObjectName string, attributeMapping msgraph.AttributeMapping, servicePrincipalId string) {
+	status, err := c.SynchronizationJobClient.SetAttributeMapping(c.Context, jobId, ruleId, targetObjectName, attributeMapping, servicePrincipalId)
+	if err != nil {
+		t.Fatalf("SynchronizationJobClient.SetAttributeMapping(): %v", err)
+	}
+
+	if status < 200 || status >= 300 {
+		t.Fatalf("SynchronizationJobClient.SetAttributeMapping(): invalid status: %d", status)
+	}
+}
+
+func testSynchronizationJobClient_RemoveAttributeMapping(t *testing.T, c *test.Test, jobId, ruleId, targetObjectName, targetAttributeName string, servicePrincipalId string) {
+	status, err := c.SynchronizationJobClient.RemoveAttributeMapping(c.Context, jobId, ruleId, targetObjectName, targetAttributeName, servicePrincipalId)
+	if err != nil {
+		t.Fatalf("SynchronizationJobClient.RemoveAttributeMapping(): %v", err)
+	}
+
+	if status < 200 || status >= 300 {
+		t.Fatalf("SynchronizationJobClient.RemoveAttributeMapping(): invalid status: %d", status)
+	}
+}
+
+func TestSynchronizationJobClient_WaitForSteadyState(t *testing.T) {
+	const (
+		servicePrincipalId = "11111111-1111-1111-1111-111111111111"
+		jobId              = "databricks.22222222222222222222222222222222.33333333-3333-3333-3333-333333333333"
+	)
+
+	// newServer returns a server which responds to each poll of the synchronization job with the next of the provided
+	// steadyStateLastAchievedTime values, repeating the last value once they are exhausted.
+	newServer := func(t *testing.T, code string, achievedTimes ...string) (*httptest.Server, *int) {
+		var mu sync.Mutex
+		polls := 0
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != fmt.Sprintf("/beta/servicePrincipals/%s/synchronization/jobs/%s", servicePrincipalId, jobId) {
+				t.Errorf("unexpected request path %q", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			mu.Lock()
+			achieved := achievedTimes[min(polls, len(achievedTimes)-1)]
+			polls++
+			mu.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"id": %q, "status": {"code": %q, "steadyStateLastAchievedTime": %q}}`, jobId, code, achieved)
+		}))
+		return ts, &polls
+	}
+
+	newClient := func(ts *httptest.Server) *msgraph.SynchronizationJobClient {
+		client := msgraph.NewSynchronizationJobClient()
+		client.BaseClient.Endpoint = ts.URL
+		client.BaseClient.RetryableClient.RetryMax = 0
+		return client
+	}
+
+	t.Run("steady", func(t *testing.T) {
+		ts, polls := newServer(t, msgraph.SynchronizationStatusCodeActive, "2024-01-01T00:00:00Z", "2024-01-01T00:00:00Z", "2024-01-01T00:05:00Z")
+		defer ts.Close()
+
+		statuses := 0
+		job, status, err := newClient(ts).WaitForSteadyState(context.Background(), jobId, servicePrincipalId, time.Millisecond, func(msgraph.SynchronizationStatus) {
+			statuses++
+		})
+		if err != nil {
+			t.Fatalf("SynchronizationJobClient.WaitForSteadyState(): %v", err)
+		}
+		if status != http.StatusOK {
+			t.Fatalf("SynchronizationJobClient.WaitForSteadyState(): invalid status: %d", status)
+		}
+		if job == nil || job.Status == nil || job.Status.SteadyStateLastAchievedTime == nil || !job.Status.SteadyStateLastAchievedTime.Equal(time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)) {
+			t.Fatalf("SynchronizationJobClient.WaitForSteadyState(): unexpected job returned: %+v", job)
+		}
+		if *polls != 3 || statuses != 3 {
+			t.Fatalf("SynchronizationJobClient.WaitForSteadyState(): expected 3 polls and status callbacks, got %d and %d", *polls, statuses)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ts, _ := newServer(t, msgraph.SynchronizationStatusCodeActive, "2024-01-01T00:00:00Z")
+		defer ts.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		job, _, err := newClient(ts).WaitForSteadyState(ctx, jobId, servicePrincipalId, 5*time.Millisecond, nil)
+		if err == nil || !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
+			t.Fatalf("SynchronizationJobClient.WaitForSteadyState(): expected deadline exceeded error, got: %v", err)
+		}
+		if job == nil {
+			t.Fatal("SynchronizationJobClient.WaitForSteadyState(): expected last polled job to be returned")
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ts, _ := newServer(t, msgraph.SynchronizationStatusCodeActive, "2024-01-01T00:00:00Z")
+		defer ts.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		statuses := 0
+		_, _, err := newClient(ts).WaitForSteadyState(ctx, jobId, servicePrincipalId, time.Hour, func(msgraph.SynchronizationStatus) {
+			statuses++
+			cancel()
+		})
+		if err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+			t.Fatalf("SynchronizationJobClient.WaitForSteadyState(): expected context canceled error, got: %v", err)
+		}
+		if statuses != 1 {
+			t.Fatalf("SynchronizationJobClient.WaitForSteadyState(): expected 1 status callback, got %d", statuses)
+		}
+	})
+
+	t.Run("quarantined", func(t *testing.T) {
+		ts, _ := newServer(t, msgraph.SynchronizationStatusCodeQuarantine, "2024-01-01T00:00:00Z")
+		defer ts.Close()
+
+		if _, _, err := newClient(ts).WaitForSteadyState(context.Background(), jobId, servicePrincipalId, time.Millisecond, nil); err == nil {
+			t.Fatal("SynchronizationJobClient.WaitForSteadyState(): expected error for quarantined job")
+		}
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		if _, _, err := msgraph.NewSynchronizationJobClient().WaitForSteadyState(context.Background(), jobId, servicePrincipalId, 0, nil); err == nil {
+			t.Fatal("SynchronizationJobClient.WaitForSteadyState(): expected error for zero interval")
+		}
+	})
+}
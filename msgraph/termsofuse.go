@@ -8,6 +8,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
 )
 
 // TermsOfUseAgreementClient performs operations on TermsOfUseAgreement.
@@ -143,3 +146,167 @@ func (c *TermsOfUseAgreementClient) Delete(ctx context.Context, id string) (int,
 	}
 	return status, nil
 }
+
+// ListAcceptances returns the acceptances and declines of a TermsOfUseAgreement agreement, optionally queried using
+// OData. For example, use the filter `state eq 'accepted'` to list users who have accepted the agreement.
+func (c *TermsOfUseAgreementClient) ListAcceptances(ctx context.Context, id string, query odata.Query) (*[]TermsOfUseAgreementAcceptance, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identityGovernance/termsOfUse/agreements/%s/acceptances", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TermsOfUseAgreementClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+	var data struct {
+		Acceptances []TermsOfUseAgreementAcceptance `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &data.Acceptances, status, nil
+}
+
+// GetFile retrieves the default file of a TermsOfUseAgreement agreement, along with its localizations.
+func (c *TermsOfUseAgreementClient) GetFile(ctx context.Context, id string) (*TermsOfUseAgreementFile, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData: odata.Query{
+			Expand: odata.Expand{Relationship: "localizations"},
+		},
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identityGovernance/termsOfUse/agreements/%s/file", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TermsOfUseAgreementClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+	var file TermsOfUseAgreementFile
+	if err := json.Unmarshal(respBody, &file); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &file, status, nil
+}
+
+// ListFileLocalizations returns the localized files of a TermsOfUseAgreement agreement, one per language.
+func (c *TermsOfUseAgreementClient) ListFileLocalizations(ctx context.Context, id string, query odata.Query) (*[]TermsOfUseAgreementFile, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identityGovernance/termsOfUse/agreements/%s/file/localizations", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TermsOfUseAgreementClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+	var data struct {
+		Localizations []TermsOfUseAgreementFile `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &data.Localizations, status, nil
+}
+
+// ListFileVersions returns the previous versions of a localized file of a TermsOfUseAgreement agreement.
+func (c *TermsOfUseAgreementClient) ListFileVersions(ctx context.Context, id, localizationId string, query odata.Query) (*[]TermsOfUseAgreementFile, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identityGovernance/termsOfUse/agreements/%s/file/localizations/%s/versions", id, localizationId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TermsOfUseAgreementClient.BaseClient.Get(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+	var data struct {
+		Versions []TermsOfUseAgreementFile `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &data.Versions, status, nil
+}
+
+// CreateFile adds a file to a TermsOfUseAgreement agreement. When a file already exists for the same language, the
+// new file replaces it as the current version. When IsMajorVersion is true, existing acceptances for that language
+// are invalidated and users must accept the agreement again.
+func (c *TermsOfUseAgreementClient) CreateFile(ctx context.Context, id string, file TermsOfUseAgreementFile) (*TermsOfUseAgreementFile, int, error) {
+	var status int
+	if file.FileName == nil || file.Language == nil {
+		return nil, status, errors.New("cannot create TermsOfUseAgreement file with nil FileName or Language")
+	}
+	if file.FileData == nil || file.FileData.Data == nil || len(*file.FileData.Data) == 0 {
+		return nil, status, errors.New("cannot create TermsOfUseAgreement file with empty FileData")
+	}
+
+	body, err := json.Marshal(file)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/identityGovernance/termsOfUse/agreements/%s/files", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TermsOfUseAgreementClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+	var newFile TermsOfUseAgreementFile
+	if err := json.Unmarshal(respBody, &newFile); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+	return &newFile, status, nil
+}
+
+// UploadMajorVersion uploads a new major version of the file for the specified language of a TermsOfUseAgreement
+// agreement, from the content of a PDF document. Existing acceptances for that language are invalidated, so users must
+// accept the agreement again.
+func (c *TermsOfUseAgreementClient) UploadMajorVersion(ctx context.Context, id, fileName, language string, pdf []byte, isDefault bool) (*TermsOfUseAgreementFile, int, error) {
+	return c.CreateFile(ctx, id, TermsOfUseAgreementFile{
+		FileName:       &fileName,
+		Language:       &language,
+		IsDefault:      &isDefault,
+		IsMajorVersion: utils.BoolPtr(true),
+		FileData: &TermsOfUseAgreementFileData{
+			Data: &pdf,
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/manicminer/hamilton/internal/test"
 	"github.com/manicminer/hamilton/internal/utils"
 	"github.com/manicminer/hamilton/msgraph"
@@ -68,6 +69,13 @@ func TestTermsOfUseAgreementClient(t *testing.T) {
 
 	testTermsOfUseAgreementClient_List(t, c)
 	testTermsOfUseAgreementClient_Get(t, c, *agreement.ID)
+	testTermsOfUseAgreementClient_ListAcceptances(t, c, *agreement.ID)
+	testTermsOfUseAgreementClient_GetFile(t, c, *agreement.ID)
+	testTermsOfUseAgreementClient_UploadMajorVersion(t, c, *agreement.ID, data)
+	localizations := testTermsOfUseAgreementClient_ListFileLocalizations(t, c, *agreement.ID)
+	for _, localization := range *localizations {
+		testTermsOfUseAgreementClient_ListFileVersions(t, c, *agreement.ID, *localization.ID)
+	}
 	testTermsOfUseAgreementClient_Delete(t, c, *agreement.ID)
 }
 
@@ -132,3 +140,64 @@ func testTermsOfUseAgreementClient_Delete(t *testing.T, c *test.Test, id string)
 		t.Fatalf("TermsOfUseAgreementClient.Delete(): invalid status: %d", status)
 	}
 }
+
+func testTermsOfUseAgreementClient_ListAcceptances(t *testing.T, c *test.Test, id string) (acceptances *[]msgraph.TermsOfUseAgreementAcceptance) {
+	acceptances, _, err := c.TermsOfUseAgreementClient.ListAcceptances(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("TermsOfUseAgreementClient.ListAcceptances(): %v", err)
+	}
+	if acceptances == nil {
+		t.Fatal("TermsOfUseAgreementClient.ListAcceptances(): acceptances was nil")
+	}
+	return
+}
+
+func testTermsOfUseAgreementClient_GetFile(t *testing.T, c *test.Test, id string) (file *msgraph.TermsOfUseAgreementFile) {
+	file, status, err := c.TermsOfUseAgreementClient.GetFile(c.Context, id)
+	if err != nil {
+		t.Fatalf("TermsOfUseAgreementClient.GetFile(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("TermsOfUseAgreementClient.GetFile(): invalid status: %d", status)
+	}
+	if file == nil {
+		t.Fatal("TermsOfUseAgreementClient.GetFile(): file was nil")
+	}
+	return
+}
+
+func testTermsOfUseAgreementClient_UploadMajorVersion(t *testing.T, c *test.Test, id string, pdf []byte) (file *msgraph.TermsOfUseAgreementFile) {
+	file, status, err := c.TermsOfUseAgreementClient.UploadMajorVersion(c.Context, id, "PolicyDocumentTestV2.pdf", "en", pdf, true)
+	if err != nil {
+		t.Fatalf("TermsOfUseAgreementClient.UploadMajorVersion(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("TermsOfUseAgreementClient.UploadMajorVersion(): invalid status: %d", status)
+	}
+	if file == nil {
+		t.Fatal("TermsOfUseAgreementClient.UploadMajorVersion(): file was nil")
+	}
+	return
+}
+
+func testTermsOfUseAgreementClient_ListFileLocalizations(t *testing.T, c *test.Test, id string) (localizations *[]msgraph.TermsOfUseAgreementFile) {
+	localizations, _, err := c.TermsOfUseAgreementClient.ListFileLocalizations(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("TermsOfUseAgreementClient.ListFileLocalizations(): %v", err)
+	}
+	if localizations == nil {
+		t.Fatal("TermsOfUseAgreementClient.ListFileLocalizations(): localizations was nil")
+	}
+	return
+}
+
+func testTermsOfUseAgreementClient_ListFileVersions(t *testing.T, c *test.Test, id, localizationId string) (versions *[]msgraph.TermsOfUseAgreementFile) {
+	versions, _, err := c.TermsOfUseAgreementClient.ListFileVersions(c.Context, id, localizationId, odata.Query{})
+	if err != nil {
+		t.Fatalf("TermsOfUseAgreementClient.ListFileVersions(): %v", err)
+	}
+	if versions == nil {
+		t.Fatal("TermsOfUseAgreementClient.ListFileVersions(): versions was nil")
+	}
+	return
+}
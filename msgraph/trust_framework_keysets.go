@@ -0,0 +1,300 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// TrustFrameworkKeySetsClient performs operations on B2C trust framework policy key sets.
+type TrustFrameworkKeySetsClient struct {
+	BaseClient Client
+}
+
+// NewTrustFrameworkKeySetsClient returns a new TrustFrameworkKeySetsClient.
+func NewTrustFrameworkKeySetsClient() *TrustFrameworkKeySetsClient {
+	return &TrustFrameworkKeySetsClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// List returns a list of trust framework key sets, optionally queried using OData.
+func (c *TrustFrameworkKeySetsClient) List(ctx context.Context, query odata.Query) (*[]TrustFrameworkKeySet, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/trustFramework/keySets",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TrustFrameworkKeySetsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		KeySets []TrustFrameworkKeySet `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.KeySets, status, nil
+}
+
+// Create creates a new, empty, trust framework key set. Note that the API prefixes the ID with `B2C_1A_` when not
+// already specified, so the ID of the returned key set should be used for subsequent operations.
+func (c *TrustFrameworkKeySetsClient) Create(ctx context.Context, keySet TrustFrameworkKeySet) (*TrustFrameworkKeySet, int, error) {
+	var status int
+
+	if keySet.ID == nil {
+		return nil, status, errors.New("cannot create trust framework key set with nil ID")
+	}
+
+	body, err := json.Marshal(keySet)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: "/trustFramework/keySets",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TrustFrameworkKeySetsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newKeySet TrustFrameworkKeySet
+	if err := json.Unmarshal(respBody, &newKeySet); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newKeySet, status, nil
+}
+
+// Get retrieves a trust framework key set.
+func (c *TrustFrameworkKeySetsClient) Get(ctx context.Context, id string, query odata.Query) (*TrustFrameworkKeySet, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/trustFramework/keySets/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TrustFrameworkKeySetsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var keySet TrustFrameworkKeySet
+	if err := json.Unmarshal(respBody, &keySet); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &keySet, status, nil
+}
+
+// Update replaces the keys in an existing trust framework key set.
+func (c *TrustFrameworkKeySetsClient) Update(ctx context.Context, keySet TrustFrameworkKeySet) (int, error) {
+	var status int
+
+	if keySet.ID == nil {
+		return status, errors.New("cannot update trust framework key set with nil ID")
+	}
+
+	body, err := json.Marshal(keySet)
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Put(ctx, PutHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/trustFramework/keySets/%s", *keySet.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("TrustFrameworkKeySetsClient.BaseClient.Put(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes a trust framework key set.
+func (c *TrustFrameworkKeySetsClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/trustFramework/keySets/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("TrustFrameworkKeySetsClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// GetActiveKey retrieves the currently active key in a trust framework key set.
+func (c *TrustFrameworkKeySetsClient) GetActiveKey(ctx context.Context, id string) (*TrustFrameworkKey, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/trustFramework/keySets/%s/getActiveKey", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TrustFrameworkKeySetsClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var key TrustFrameworkKey
+	if err := json.Unmarshal(respBody, &key); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &key, status, nil
+}
+
+// GenerateKey generates a new key in a trust framework key set. notBefore and expires are optional.
+func (c *TrustFrameworkKeySetsClient) GenerateKey(ctx context.Context, id string, use TrustFrameworkKeyUse, kty TrustFrameworkKeyType, notBefore, expires *time.Time) (*TrustFrameworkKey, int, error) {
+	return c.postKey(ctx, id, "generateKey", struct {
+		Use TrustFrameworkKeyUse  `json:"use"`
+		Kty TrustFrameworkKeyType `json:"kty"`
+		Nbf *int64                `json:"nbf,omitempty"`
+		Exp *int64                `json:"exp,omitempty"`
+	}{
+		Use: use,
+		Kty: kty,
+		Nbf: unixTimePtr(notBefore),
+		Exp: unixTimePtr(expires),
+	})
+}
+
+// UploadSecret uploads a shared secret, such as an application client secret, to a trust framework key set.
+// notBefore and expires are optional.
+func (c *TrustFrameworkKeySetsClient) UploadSecret(ctx context.Context, id string, use TrustFrameworkKeyUse, secret string, notBefore, expires *time.Time) (*TrustFrameworkKey, int, error) {
+	if secret == "" {
+		return nil, 0, errors.New("cannot upload empty secret")
+	}
+
+	return c.postKey(ctx, id, "uploadSecret", struct {
+		Use TrustFrameworkKeyUse `json:"use"`
+		K   string               `json:"k"`
+		Nbf *int64               `json:"nbf,omitempty"`
+		Exp *int64               `json:"exp,omitempty"`
+	}{
+		Use: use,
+		K:   secret,
+		Nbf: unixTimePtr(notBefore),
+		Exp: unixTimePtr(expires),
+	})
+}
+
+// UploadCertificate uploads a certificate to a trust framework key set. certificate should be DER encoded.
+func (c *TrustFrameworkKeySetsClient) UploadCertificate(ctx context.Context, id string, certificate []byte) (*TrustFrameworkKey, int, error) {
+	if len(certificate) == 0 {
+		return nil, 0, errors.New("cannot upload empty certificate")
+	}
+
+	return c.postKey(ctx, id, "uploadCertificate", struct {
+		Key string `json:"key"`
+	}{
+		Key: base64.StdEncoding.EncodeToString(certificate),
+	})
+}
+
+// UploadPkcs12 uploads a PKCS#12 (PFX) archive containing a certificate and private key to a trust framework key set.
+func (c *TrustFrameworkKeySetsClient) UploadPkcs12(ctx context.Context, id string, pkcs12 []byte, password string) (*TrustFrameworkKey, int, error) {
+	if len(pkcs12) == 0 {
+		return nil, 0, errors.New("cannot upload empty PKCS#12 archive")
+	}
+
+	return c.postKey(ctx, id, "uploadPkcs12", struct {
+		Key      string `json:"key"`
+		Password string `json:"password,omitempty"`
+	}{
+		Key:      base64.StdEncoding.EncodeToString(pkcs12),
+		Password: password,
+	})
+}
+
+// postKey invokes an action on a trust framework key set which returns the resulting key.
+func (c *TrustFrameworkKeySetsClient) postKey(ctx context.Context, id, action string, input interface{}) (*TrustFrameworkKey, int, error) {
+	var status int
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/trustFramework/keySets/%s/%s", id, action),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TrustFrameworkKeySetsClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var key TrustFrameworkKey
+	if err := json.Unmarshal(respBody, &key); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &key, status, nil
+}
+
+func unixTimePtr(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	v := t.Unix()
+	return &v
+}
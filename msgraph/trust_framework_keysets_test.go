@@ -0,0 +1,207 @@
+package msgraph_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/test"
+	"github.com/manicminer/hamilton/internal/utils"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestTrustFrameworkKeySetsClient(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	keySet := testTrustFrameworkKeySetsClient_Create(t, c, msgraph.TrustFrameworkKeySet{
+		ID: utils.StringPtr(fmt.Sprintf("test-keyset-%s", c.RandomString)),
+	})
+	testTrustFrameworkKeySetsClient_Get(t, c, *keySet.ID)
+	testTrustFrameworkKeySetsClient_List(t, c)
+
+	expires := time.Now().AddDate(0, 1, 0)
+	testTrustFrameworkKeySetsClient_GenerateKey(t, c, *keySet.ID, &expires)
+	testTrustFrameworkKeySetsClient_GetActiveKey(t, c, *keySet.ID)
+
+	secretKeySet := testTrustFrameworkKeySetsClient_Create(t, c, msgraph.TrustFrameworkKeySet{
+		ID: utils.StringPtr(fmt.Sprintf("test-secret-%s", c.RandomString)),
+	})
+	testTrustFrameworkKeySetsClient_UploadSecret(t, c, *secretKeySet.ID, &expires)
+
+	testTrustFrameworkKeySetsClient_Delete(t, c, *secretKeySet.ID)
+	testTrustFrameworkKeySetsClient_Delete(t, c, *keySet.ID)
+}
+
+func testTrustFrameworkKeySetsClient_Create(t *testing.T, c *test.Test, k msgraph.TrustFrameworkKeySet) *msgraph.TrustFrameworkKeySet {
+	keySet, status, err := c.TrustFrameworkKeySetsClient.Create(c.Context, k)
+	if err != nil {
+		t.Fatalf("TrustFrameworkKeySetsClient.Create(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("TrustFrameworkKeySetsClient.Create(): invalid status: %d", status)
+	}
+	if keySet == nil {
+		t.Fatal("TrustFrameworkKeySetsClient.Create(): keySet was nil")
+	}
+	if keySet.ID == nil {
+		t.Fatal("TrustFrameworkKeySetsClient.Create(): keySet.ID was nil")
+	}
+	return keySet
+}
+
+func testTrustFrameworkKeySetsClient_Get(t *testing.T, c *test.Test, id string) *msgraph.TrustFrameworkKeySet {
+	keySet, status, err := c.TrustFrameworkKeySetsClient.Get(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("TrustFrameworkKeySetsClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("TrustFrameworkKeySetsClient.Get(): invalid status: %d", status)
+	}
+	if keySet == nil {
+		t.Fatal("TrustFrameworkKeySetsClient.Get(): keySet was nil")
+	}
+	return keySet
+}
+
+func testTrustFrameworkKeySetsClient_List(t *testing.T, c *test.Test) *[]msgraph.TrustFrameworkKeySet {
+	keySets, _, err := c.TrustFrameworkKeySetsClient.List(c.Context, odata.Query{Top: 10})
+	if err != nil {
+		t.Fatalf("TrustFrameworkKeySetsClient.List(): %v", err)
+	}
+	if keySets == nil {
+		t.Fatal("TrustFrameworkKeySetsClient.List(): keySets was nil")
+	}
+	return keySets
+}
+
+func testTrustFrameworkKeySetsClient_GenerateKey(t *testing.T, c *test.Test, id string, expires *time.Time) *msgraph.TrustFrameworkKey {
+	key, status, err := c.TrustFrameworkKeySetsClient.GenerateKey(c.Context, id, msgraph.TrustFrameworkKeyUseSignature, msgraph.TrustFrameworkKeyTypeRsa, nil, expires)
+	if err != nil {
+		t.Fatalf("TrustFrameworkKeySetsClient.GenerateKey(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("TrustFrameworkKeySetsClient.GenerateKey(): invalid status: %d", status)
+	}
+	if key == nil {
+		t.Fatal("TrustFrameworkKeySetsClient.GenerateKey(): key was nil")
+	}
+	return key
+}
+
+func testTrustFrameworkKeySetsClient_GetActiveKey(t *testing.T, c *test.Test, id string) *msgraph.TrustFrameworkKey {
+	key, status, err := c.TrustFrameworkKeySetsClient.GetActiveKey(c.Context, id)
+	if err != nil {
+		t.Fatalf("TrustFrameworkKeySetsClient.GetActiveKey(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("TrustFrameworkKeySetsClient.GetActiveKey(): invalid status: %d", status)
+	}
+	if key == nil {
+		t.Fatal("TrustFrameworkKeySetsClient.GetActiveKey(): key was nil")
+	}
+	return key
+}
+
+func testTrustFrameworkKeySetsClient_UploadSecret(t *testing.T, c *test.Test, id string, expires *time.Time) *msgraph.TrustFrameworkKey {
+	key, status, err := c.TrustFrameworkKeySetsClient.UploadSecret(c.Context, id, msgraph.TrustFrameworkKeyUseSignature, fmt.Sprintf("secret-%s", c.RandomString), nil, expires)
+	if err != nil {
+		t.Fatalf("TrustFrameworkKeySetsClient.UploadSecret(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("TrustFrameworkKeySetsClient.UploadSecret(): invalid status: %d", status)
+	}
+	if key == nil {
+		t.Fatal("TrustFrameworkKeySetsClient.UploadSecret(): key was nil")
+	}
+	return key
+}
+
+func testTrustFrameworkKeySetsClient_Delete(t *testing.T, c *test.Test, id string) {
+	status, err := c.TrustFrameworkKeySetsClient.Delete(c.Context, id)
+	if err != nil {
+		t.Fatalf("TrustFrameworkKeySetsClient.Delete(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("TrustFrameworkKeySetsClient.Delete(): invalid status: %d", status)
+	}
+}
+
+// Certificates and PKCS#12 archives must be issued for the tenant, so UploadCertificate and UploadPkcs12 are tested
+// against a local server.
+func TestTrustFrameworkKeySetsClient_UploadCertificateAndPkcs12(t *testing.T) {
+	certificate := []byte("test-certificate-der")
+	pkcs12 := []byte("test-pkcs12-archive")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method %q for %q", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request body for %q: %v", r.URL.Path, err)
+		}
+
+		switch r.URL.Path {
+		case "/beta/trustFramework/keySets/B2C_1A_TestKeySet/uploadCertificate":
+			if body["key"] != base64.StdEncoding.EncodeToString(certificate) || len(body) != 1 {
+				t.Errorf("unexpected uploadCertificate request body: %v", body)
+			}
+		case "/beta/trustFramework/keySets/B2C_1A_TestKeySet/uploadPkcs12":
+			if body["key"] != base64.StdEncoding.EncodeToString(pkcs12) || body["password"] != "Pa55w0rd" {
+				t.Errorf("unexpected uploadPkcs12 request body: %v", body)
+			}
+		default:
+			t.Errorf("unexpected request path %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"kid":"test-kid","kty":"RSA","use":"sig","x5t":"test-thumbprint"}`))
+	}))
+	defer ts.Close()
+
+	client := msgraph.NewTrustFrameworkKeySetsClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	key, status, err := client.UploadCertificate(context.Background(), "B2C_1A_TestKeySet", certificate)
+	if err != nil {
+		t.Fatalf("TrustFrameworkKeySetsClient.UploadCertificate(): %v", err)
+	}
+	if status != http.StatusOK {
+		t.Fatalf("TrustFrameworkKeySetsClient.UploadCertificate(): invalid status: %d", status)
+	}
+	if key == nil || key.Kid == nil || *key.Kid != "test-kid" {
+		t.Fatalf("TrustFrameworkKeySetsClient.UploadCertificate(): unexpected key: %v", key)
+	}
+
+	key, status, err = client.UploadPkcs12(context.Background(), "B2C_1A_TestKeySet", pkcs12, "Pa55w0rd")
+	if err != nil {
+		t.Fatalf("TrustFrameworkKeySetsClient.UploadPkcs12(): %v", err)
+	}
+	if status != http.StatusOK {
+		t.Fatalf("TrustFrameworkKeySetsClient.UploadPkcs12(): invalid status: %d", status)
+	}
+	if key == nil || key.X5T == nil || *key.X5T != "test-thumbprint" {
+		t.Fatalf("TrustFrameworkKeySetsClient.UploadPkcs12(): unexpected key: %v", key)
+	}
+
+	if _, _, err := client.UploadCertificate(context.Background(), "B2C_1A_TestKeySet", nil); err == nil {
+		t.Fatal("TrustFrameworkKeySetsClient.UploadCertificate(): expected error for empty certificate")
+	}
+	if _, _, err := client.UploadPkcs12(context.Background(), "B2C_1A_TestKeySet", nil, "Pa55w0rd"); err == nil {
+		t.Fatal("TrustFrameworkKeySetsClient.UploadPkcs12(): expected error for empty archive")
+	}
+}
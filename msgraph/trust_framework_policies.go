@@ -0,0 +1,116 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// TrustFrameworkPoliciesClient performs operations on B2C trust framework (custom) policies.
+type TrustFrameworkPoliciesClient struct {
+	BaseClient Client
+}
+
+// NewTrustFrameworkPoliciesClient returns a new TrustFrameworkPoliciesClient.
+func NewTrustFrameworkPoliciesClient() *TrustFrameworkPoliciesClient {
+	return &TrustFrameworkPoliciesClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// List returns a list of trust framework policies, optionally queried using OData.
+func (c *TrustFrameworkPoliciesClient) List(ctx context.Context, query odata.Query) (*[]TrustFrameworkPolicy, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/trustFramework/policies",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TrustFrameworkPoliciesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Policies []TrustFrameworkPolicy `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Policies, status, nil
+}
+
+// Get downloads the XML content of a trust framework policy.
+func (c *TrustFrameworkPoliciesClient) Get(ctx context.Context, id string) ([]byte, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/trustFramework/policies/%s/$value", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("TrustFrameworkPoliciesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	return respBody, status, nil
+}
+
+// Upload creates a new trust framework policy, or replaces an existing policy, having the specified ID. The ID must
+// match the PolicyId attribute of the TrustFrameworkPolicy element in the policy XML.
+func (c *TrustFrameworkPoliciesClient) Upload(ctx context.Context, id string, policyXml []byte) (int, error) {
+	var status int
+
+	if len(policyXml) == 0 {
+		return status, errors.New("cannot upload empty trust framework policy")
+	}
+
+	_, status, _, err := c.BaseClient.Put(ctx, PutHttpRequestInput{
+		Body:             policyXml,
+		ContentType:      "application/xml",
+		ValidStatusCodes: []int{http.StatusOK, http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/trustFramework/policies/%s/$value", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("TrustFrameworkPoliciesClient.BaseClient.Put(): %v", err)
+	}
+
+	return status, nil
+}
+
+// Delete removes a trust framework policy.
+func (c *TrustFrameworkPoliciesClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/trustFramework/policies/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("TrustFrameworkPoliciesClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,96 @@
+package msgraph_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/test"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestTrustFrameworkPoliciesClient(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	policies := testTrustFrameworkPoliciesClient_List(t, c)
+	if len(*policies) > 0 && (*policies)[0].ID != nil {
+		testTrustFrameworkPoliciesClient_Get(t, c, *(*policies)[0].ID)
+	}
+}
+
+func testTrustFrameworkPoliciesClient_List(t *testing.T, c *test.Test) *[]msgraph.TrustFrameworkPolicy {
+	policies, _, err := c.TrustFrameworkPoliciesClient.List(c.Context, odata.Query{})
+	if err != nil {
+		t.Fatalf("TrustFrameworkPoliciesClient.List(): %v", err)
+	}
+	if policies == nil {
+		t.Fatal("TrustFrameworkPoliciesClient.List(): policies was nil")
+	}
+	return policies
+}
+
+func testTrustFrameworkPoliciesClient_Get(t *testing.T, c *test.Test, id string) []byte {
+	policyXml, status, err := c.TrustFrameworkPoliciesClient.Get(c.Context, id)
+	if err != nil {
+		t.Fatalf("TrustFrameworkPoliciesClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("TrustFrameworkPoliciesClient.Get(): invalid status: %d", status)
+	}
+	if len(policyXml) == 0 {
+		t.Fatal("TrustFrameworkPoliciesClient.Get(): policyXml was empty")
+	}
+	return policyXml
+}
+
+// Uploading a policy requires a complete set of B2C custom policies for the tenant, so Upload is tested against a
+// local server.
+func TestTrustFrameworkPoliciesClient_Upload(t *testing.T) {
+	policyXml := []byte(`<TrustFrameworkPolicy PolicyId="B2C_1A_TrustFrameworkBase"></TrustFrameworkPolicy>`)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected method %q for %q", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.URL.Path != "/beta/trustFramework/policies/B2C_1A_TrustFrameworkBase/$value" {
+			t.Errorf("unexpected request path %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/xml" {
+			t.Errorf("unexpected content type %q", contentType)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request body: %v", err)
+		}
+		if !bytes.Equal(body, policyXml) {
+			t.Errorf("unexpected request body: %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	client := msgraph.NewTrustFrameworkPoliciesClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	status, err := client.Upload(context.Background(), "B2C_1A_TrustFrameworkBase", policyXml)
+	if err != nil {
+		t.Fatalf("TrustFrameworkPoliciesClient.Upload(): %v", err)
+	}
+	if status != http.StatusCreated {
+		t.Fatalf("TrustFrameworkPoliciesClient.Upload(): invalid status: %d", status)
+	}
+
+	if _, err := client.Upload(context.Background(), "B2C_1A_TrustFrameworkBase", nil); err == nil {
+		t.Fatal("TrustFrameworkPoliciesClient.Upload(): expected error for empty policy")
+	}
+}
@@ -10,6 +10,12 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 )
 
+// defaultUserProperties are the properties of a User returned by the API when no properties are selected.
+var defaultUserProperties = []string{
+	"businessPhones", "displayName", "givenName", "id", "jobTitle", "mail", "mobilePhone", "officeLocation",
+	"preferredLanguage", "surname", "userPrincipalName",
+}
+
 // UsersClient performs operations on Users.
 type UsersClient struct {
 	BaseClient Client
@@ -125,7 +131,8 @@ func (c *UsersClient) Get(ctx context.Context, id string, query odata.Query) (*U
 	return &user, status, nil
 }
 
-// GetWithSchemaExtensions retrieves a User, including the values for any specified schema extensions
+// GetWithSchemaExtensions retrieves a User, including the values for any specified schema extensions.
+// This makes two requests, see GetWithExtensions for a more efficient alternative.
 func (c *UsersClient) GetWithSchemaExtensions(ctx context.Context, id string, query odata.Query, schemaExtensions *[]SchemaExtensionData) (*User, int, error) {
 	var sel []string
 	if len(query.Select) > 0 {
@@ -169,6 +176,39 @@ func (c *UsersClient) GetWithSchemaExtensions(ctx context.Context, id string, qu
 	return user, status, nil
 }
 
+// GetWithExtensions retrieves a User in a single request, including the values for any specified schema extensions
+// or directory extensions. The IDs of the extensions are merged into query.Select, or into the default properties when
+// query.Select is empty, since extension values are only returned when selected. Use NewSchemaExtensionData() and
+// GetSchemaExtensionValue() for typed values.
+func (c *UsersClient) GetWithExtensions(ctx context.Context, id string, query odata.Query, schemaExtensions *[]SchemaExtensionData) (*User, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  selectSchemaExtensions(query, schemaExtensions, defaultUserProperties),
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	user := User{
+		SchemaExtensions: schemaExtensions,
+	}
+	if err := json.Unmarshal(respBody, &user); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &user, status, nil
+}
+
 // GetDeleted retrieves a deleted User.
 func (c *UsersClient) GetDeleted(ctx context.Context, id string, query odata.Query) (*User, int, error) {
 	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
@@ -441,3 +481,527 @@ func (c *UsersClient) UploadThumbnailPhoto(ctx context.Context, userId, contentT
 	}
 	return status, nil
 }
+
+// RevokeSignInSessions invalidates all refresh tokens and session cookies issued to the specified user, requiring
+// them to sign in again to all applications.
+func (c *UsersClient) RevokeSignInSessions(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/revokeSignInSessions", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("UsersClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// InvalidateAllRefreshTokens invalidates all refresh tokens issued to applications for the specified user.
+// This is only available in the beta API and has been superseded by RevokeSignInSessions.
+func (c *UsersClient) InvalidateAllRefreshTokens(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/invalidateAllRefreshTokens", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("UsersClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ChangePassword updates the password for the specified user. This requires the current password, and is only
+// supported when authenticated as the user whose password is being changed.
+func (c *UsersClient) ChangePassword(ctx context.Context, id, currentPassword, newPassword string) (int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		CurrentPassword string `json:"currentPassword"`
+		NewPassword     string `json:"newPassword"`
+	}{
+		CurrentPassword: currentPassword,
+		NewPassword:     newPassword,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/changePassword", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("UsersClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// ExportPersonalData submits a data policy operation request to export the personal data for the specified user.
+// storageLocation is a shared access signature (SAS) URL to an Azure Storage account to which the data will be exported.
+// The export is processed asynchronously and the URL of the resulting data policy operation is returned.
+func (c *UsersClient) ExportPersonalData(ctx context.Context, id, storageLocation string) (*string, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		StorageLocation string `json:"storageLocation"`
+	}{
+		StorageLocation: storageLocation,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusAccepted},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/exportPersonalData", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Post(): %v", err)
+	}
+	defer resp.Body.Close()
+
+	location := resp.Header.Get("Location")
+
+	return &location, status, nil
+}
+
+// ReprocessLicenseAssignment reprocesses all group-based license assignments for the specified user.
+func (c *UsersClient) ReprocessLicenseAssignment(ctx context.Context, id string) (*User, int, error) {
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/reprocessLicenseAssignment", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var user User
+	if err := json.Unmarshal(respBody, &user); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &user, status, nil
+}
+
+// ListDirectReports returns the users and organizational contacts who report to the specified user, optionally queried
+// using OData.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *UsersClient) ListDirectReports(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/directReports", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
+// ListManagerChain returns the chain of managers for the specified user, starting with their direct manager and
+// ending with the top-most manager in the organization.
+func (c *UsersClient) ListManagerChain(ctx context.Context, id string) (*[]User, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData: odata.Query{
+			ConsistencyLevel: odata.ConsistencyLevelEventual,
+			Count:            true,
+			Expand: odata.Expand{
+				Relationship: "manager($levels=max)",
+			},
+			Select: []string{"id"},
+		},
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	// Each manager is nested inside the manager property of the previous one
+	type managerChain struct {
+		Manager json.RawMessage `json:"manager"`
+	}
+
+	managers := make([]User, 0)
+	for {
+		var chain managerChain
+		if err := json.Unmarshal(respBody, &chain); err != nil {
+			return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+		}
+		if len(chain.Manager) == 0 || string(chain.Manager) == "null" {
+			break
+		}
+
+		var manager User
+		if err := json.Unmarshal(chain.Manager, &manager); err != nil {
+			return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+		}
+		managers = append(managers, manager)
+
+		respBody = chain.Manager
+	}
+
+	return &managers, status, nil
+}
+
+// ListMemberOf returns the groups, directory roles and administrative units that the specified user is a direct member
+// of, optionally queried using OData.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *UsersClient) ListMemberOf(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/memberOf", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
+// ListOwnedObjects returns the directory objects owned by the specified user, optionally queried using OData.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *UsersClient) ListOwnedObjects(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/ownedObjects", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
+// ListOwnedDevices returns the devices owned by the specified user, optionally queried using OData.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *UsersClient) ListOwnedDevices(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/ownedDevices", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
+// ListRegisteredDevices returns the devices registered to the specified user, optionally queried using OData.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *UsersClient) ListRegisteredDevices(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/registeredDevices", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
+// ListCreatedObjects returns the directory objects created by the specified user, optionally queried using OData.
+// Each object is unmarshaled into a concrete model according to its type, see DirectoryObjects.
+func (c *UsersClient) ListCreatedObjects(ctx context.Context, id string, query odata.Query) (*DirectoryObjects, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/createdObjects", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	objects, err := unmarshalDirectoryObjects(respBody)
+	if err != nil {
+		return nil, status, fmt.Errorf("unmarshalDirectoryObjects(): %v", err)
+	}
+
+	return objects, status, nil
+}
+
+// CheckMemberGroups checks the specified user for membership of the specified groups, returning the IDs of those groups
+// of which the user is a member, either directly or transitively.
+func (c *UsersClient) CheckMemberGroups(ctx context.Context, id string, groupIds []string) (*[]string, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		GroupIds []string `json:"groupIds"`
+	}{
+		GroupIds: groupIds,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/checkMemberGroups", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		IDs []string `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.IDs, status, nil
+}
+
+// CheckMemberObjects checks the specified user for membership of the specified groups, directory roles or
+// administrative units, returning the IDs of those objects of which the user is a member, either directly or
+// transitively.
+func (c *UsersClient) CheckMemberObjects(ctx context.Context, id string, ids []string) (*[]string, int, error) {
+	var status int
+
+	body, err := json.Marshal(struct {
+		Ids []string `json:"ids"`
+	}{
+		Ids: ids,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/checkMemberObjects", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		IDs []string `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.IDs, status, nil
+}
+
+// ConvertExternalToInternalMemberUser converts an externally authenticated user, such as a B2B guest, into an internal
+// member user which authenticates with the tenant. A password profile is required when the user is not synchronized
+// from on-premises, and the user principal name must use a verified domain of the tenant.
+func (c *UsersClient) ConvertExternalToInternalMemberUser(ctx context.Context, id, userPrincipalName string, passwordProfile *UserPasswordProfile) (*ConversionUserDetails, int, error) {
+	var status int
+
+	if userPrincipalName == "" {
+		return nil, status, fmt.Errorf("cannot convert user with empty userPrincipalName")
+	}
+
+	body, err := json.Marshal(struct {
+		UserPrincipalName string               `json:"userPrincipalName"`
+		PasswordProfile   *UserPasswordProfile `json:"passwordProfile,omitempty"`
+	}{
+		UserPrincipalName: userPrincipalName,
+		PasswordProfile:   passwordProfile,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/convertExternalToInternalMemberUser", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var details ConversionUserDetails
+	if err := json.Unmarshal(respBody, &details); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &details, status, nil
+}
+
+// ListAgreementAcceptances returns the terms of use agreements accepted or declined by a user.
+func (c *UsersClient) ListAgreementAcceptances(ctx context.Context, id string, query odata.Query) (*[]TermsOfUseAgreementAcceptance, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		DisablePaging:          query.Top > 0,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/users/%s/agreementAcceptances", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("UsersClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		AgreementAcceptances []TermsOfUseAgreementAcceptance `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.AgreementAcceptances, status, nil
+}
@@ -1,7 +1,11 @@
 package msgraph_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -60,15 +64,27 @@ func TestUsersClient(t *testing.T) {
 	testGroupsClient_AddMembers(t, c, groupChild)
 
 	testUsersClient_ListGroupMemberships(t, c, *user.ID())
+	testUsersClient_ListMemberOf(t, c, *user.ID())
+	testUsersClient_ListAgreementAcceptances(t, c, *user.ID())
+	testUsersClient_CheckMemberGroups(t, c, *user.ID(), []string{*groupParent.ID(), *groupChild.ID()})
+	testUsersClient_CheckMemberObjects(t, c, *user.ID(), []string{*groupParent.ID(), *groupChild.ID()})
 	testGroupsClient_Delete(t, c, *groupParent.ID())
 	testGroupsClient_Delete(t, c, *groupChild.ID())
 
 	testUsersClient_AssignManager(t, c, *user.ID(), *manager)
 	testUsersClient_GetManager(t, c, *user.ID())
+	testUsersClient_ListDirectReports(t, c, *manager.ID(), *user.ID())
+	testUsersClient_ListManagerChain(t, c, *user.ID())
 	testUsersClient_DeleteManager(t, c, *user.ID())
 	testUsersClient_Delete(t, c, *manager.ID())
 
 	testUsersClient_UploadThumbnail(t, c, *user)
+	testUsersClient_RevokeSignInSessions(t, c, *user.ID())
+	testUsersClient_ReprocessLicenseAssignment(t, c, *user.ID())
+	testUsersClient_ListOwnedObjects(t, c, *user.ID())
+	testUsersClient_ListOwnedDevices(t, c, *user.ID())
+	testUsersClient_ListRegisteredDevices(t, c, *user.ID())
+	testUsersClient_ListCreatedObjects(t, c, *user.ID())
 
 	testUsersClient_Delete(t, c, *user.ID())
 	testUsersClient_ListDeleted(t, c, *user.ID())
@@ -265,6 +281,30 @@ func testUsersClient_DeleteManager(t *testing.T, c *test.Test, id string) (user
 	return
 }
 
+func testUsersClient_RevokeSignInSessions(t *testing.T, c *test.Test, id string) {
+	status, err := c.UsersClient.RevokeSignInSessions(c.Context, id)
+	if err != nil {
+		t.Fatalf("UsersClient.RevokeSignInSessions(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.RevokeSignInSessions(): invalid status: %d", status)
+	}
+}
+
+func testUsersClient_ReprocessLicenseAssignment(t *testing.T, c *test.Test, id string) (user *msgraph.User) {
+	user, status, err := c.UsersClient.ReprocessLicenseAssignment(c.Context, id)
+	if err != nil {
+		t.Fatalf("UsersClient.ReprocessLicenseAssignment(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.ReprocessLicenseAssignment(): invalid status: %d", status)
+	}
+	if user == nil {
+		t.Fatal("UsersClient.ReprocessLicenseAssignment(): user was nil")
+	}
+	return
+}
+
 func testUsersClient_UploadThumbnail(t *testing.T, c *test.Test, a msgraph.User) {
 	b, err := os.ReadFile(filepath.Join("..", "internal", "test", "testlogo.png"))
 	if err != nil {
@@ -278,3 +318,217 @@ func testUsersClient_UploadThumbnail(t *testing.T, c *test.Test, a msgraph.User)
 		t.Fatalf("UsersClient.UploadThumbnailPhoto(): invalid status: %d", status)
 	}
 }
+
+func testUsersClient_ListDirectReports(t *testing.T, c *test.Test, id, expectedId string) (directReports *msgraph.DirectoryObjects) {
+	directReports, status, err := c.UsersClient.ListDirectReports(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("UsersClient.ListDirectReports(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.ListDirectReports(): invalid status: %d", status)
+	}
+	if directReports == nil {
+		t.Fatal("UsersClient.ListDirectReports(): directReports was nil")
+	}
+	found := false
+	for _, directReport := range *directReports {
+		if user, ok := directReport.(*msgraph.User); ok && user.ID() != nil && *user.ID() == expectedId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Fatalf("UsersClient.ListDirectReports(): expected user ID %q in result", expectedId)
+	}
+	return
+}
+
+func testUsersClient_ListManagerChain(t *testing.T, c *test.Test, id string) (managers *[]msgraph.User) {
+	managers, status, err := c.UsersClient.ListManagerChain(c.Context, id)
+	if err != nil {
+		t.Fatalf("UsersClient.ListManagerChain(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.ListManagerChain(): invalid status: %d", status)
+	}
+	if managers == nil {
+		t.Fatal("UsersClient.ListManagerChain(): managers was nil")
+	}
+	if len(*managers) == 0 {
+		t.Fatal("UsersClient.ListManagerChain(): expected at least 1 manager, was: 0")
+	}
+	return
+}
+
+func testUsersClient_ListMemberOf(t *testing.T, c *test.Test, id string) (objects *msgraph.DirectoryObjects) {
+	objects, status, err := c.UsersClient.ListMemberOf(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("UsersClient.ListMemberOf(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.ListMemberOf(): invalid status: %d", status)
+	}
+	if objects == nil {
+		t.Fatal("UsersClient.ListMemberOf(): objects was nil")
+	}
+	return
+}
+
+func testUsersClient_ListOwnedObjects(t *testing.T, c *test.Test, id string) (objects *msgraph.DirectoryObjects) {
+	objects, status, err := c.UsersClient.ListOwnedObjects(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("UsersClient.ListOwnedObjects(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.ListOwnedObjects(): invalid status: %d", status)
+	}
+	if objects == nil {
+		t.Fatal("UsersClient.ListOwnedObjects(): objects was nil")
+	}
+	return
+}
+
+func testUsersClient_ListOwnedDevices(t *testing.T, c *test.Test, id string) (objects *msgraph.DirectoryObjects) {
+	objects, status, err := c.UsersClient.ListOwnedDevices(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("UsersClient.ListOwnedDevices(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.ListOwnedDevices(): invalid status: %d", status)
+	}
+	if objects == nil {
+		t.Fatal("UsersClient.ListOwnedDevices(): objects was nil")
+	}
+	return
+}
+
+func testUsersClient_ListRegisteredDevices(t *testing.T, c *test.Test, id string) (objects *msgraph.DirectoryObjects) {
+	objects, status, err := c.UsersClient.ListRegisteredDevices(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("UsersClient.ListRegisteredDevices(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.ListRegisteredDevices(): invalid status: %d", status)
+	}
+	if objects == nil {
+		t.Fatal("UsersClient.ListRegisteredDevices(): objects was nil")
+	}
+	return
+}
+
+func testUsersClient_ListCreatedObjects(t *testing.T, c *test.Test, id string) (objects *msgraph.DirectoryObjects) {
+	objects, status, err := c.UsersClient.ListCreatedObjects(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("UsersClient.ListCreatedObjects(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.ListCreatedObjects(): invalid status: %d", status)
+	}
+	if objects == nil {
+		t.Fatal("UsersClient.ListCreatedObjects(): objects was nil")
+	}
+	return
+}
+
+func testUsersClient_CheckMemberGroups(t *testing.T, c *test.Test, id string, ids []string) (result *[]string) {
+	result, status, err := c.UsersClient.CheckMemberGroups(c.Context, id, ids)
+	if err != nil {
+		t.Fatalf("UsersClient.CheckMemberGroups(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.CheckMemberGroups(): invalid status: %d", status)
+	}
+	if result == nil {
+		t.Fatal("UsersClient.CheckMemberGroups(): result was nil")
+	}
+	if len(*result) != len(ids) {
+		t.Fatalf("UsersClient.CheckMemberGroups(): expected %d results, was: %d", len(ids), len(*result))
+	}
+	return
+}
+
+func testUsersClient_CheckMemberObjects(t *testing.T, c *test.Test, id string, ids []string) (result *[]string) {
+	result, status, err := c.UsersClient.CheckMemberObjects(c.Context, id, ids)
+	if err != nil {
+		t.Fatalf("UsersClient.CheckMemberObjects(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("UsersClient.CheckMemberObjects(): invalid status: %d", status)
+	}
+	if result == nil {
+		t.Fatal("UsersClient.CheckMemberObjects(): result was nil")
+	}
+	if len(*result) != len(ids) {
+		t.Fatalf("UsersClient.CheckMemberObjects(): expected %d results, was: %d", len(ids), len(*result))
+	}
+	return
+}
+
+func testUsersClient_ListAgreementAcceptances(t *testing.T, c *test.Test, id string) (acceptances *[]msgraph.TermsOfUseAgreementAcceptance) {
+	acceptances, _, err := c.UsersClient.ListAgreementAcceptances(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("UsersClient.ListAgreementAcceptances(): %v", err)
+	}
+	if acceptances == nil {
+		t.Fatal("UsersClient.ListAgreementAcceptances(): acceptances was nil")
+	}
+	return
+}
+
+// ChangePassword can only be called when authenticated as the user whose password is being changed, and
+// ExportPersonalData requires an Azure Storage SAS URL, so these are tested against a local server.
+func TestUsersClient_PasswordAndPersonalData(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("unexpected method %q for %q", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request body for %q: %v", r.URL.Path, err)
+		}
+
+		switch r.URL.Path {
+		case "/beta/users/11111111-1111-1111-1111-111111111111/changePassword":
+			if body["currentPassword"] != "0ldPa55w0rd" || body["newPassword"] != "N3wPa55w0rd" {
+				t.Errorf("unexpected changePassword request body: %v", body)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case "/beta/users/11111111-1111-1111-1111-111111111111/exportPersonalData":
+			if body["storageLocation"] != "https://example.blob.core.windows.net/export?sig=test" {
+				t.Errorf("unexpected exportPersonalData request body: %v", body)
+			}
+			w.Header().Set("Location", "https://graph.microsoft.com/v1.0/dataPolicyOperations/22222222-2222-2222-2222-222222222222")
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("unexpected request path %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client := msgraph.NewUsersClient()
+	client.BaseClient.Endpoint = ts.URL
+	client.BaseClient.RetryableClient.RetryMax = 0
+
+	status, err := client.ChangePassword(context.Background(), "11111111-1111-1111-1111-111111111111", "0ldPa55w0rd", "N3wPa55w0rd")
+	if err != nil {
+		t.Fatalf("UsersClient.ChangePassword(): %v", err)
+	}
+	if status != http.StatusNoContent {
+		t.Fatalf("UsersClient.ChangePassword(): invalid status: %d", status)
+	}
+
+	location, status, err := client.ExportPersonalData(context.Background(), "11111111-1111-1111-1111-111111111111", "https://example.blob.core.windows.net/export?sig=test")
+	if err != nil {
+		t.Fatalf("UsersClient.ExportPersonalData(): %v", err)
+	}
+	if status != http.StatusAccepted {
+		t.Fatalf("UsersClient.ExportPersonalData(): invalid status: %d", status)
+	}
+	if location == nil || *location != "https://graph.microsoft.com/v1.0/dataPolicyOperations/22222222-2222-2222-2222-222222222222" {
+		t.Fatalf("UsersClient.ExportPersonalData(): unexpected location: %v", location)
+	}
+}
@@ -151,6 +151,28 @@ const (
 	AccessReviewRecurrenceTypeAnnual     AccessReviewRecurrenceType = "annual"
 )
 
+type AdminConsentAction = string
+
+const (
+	AdminConsentActionAdded     AdminConsentAction = "added"
+	AdminConsentActionRemoved   AdminConsentAction = "removed"
+	AdminConsentActionUnchanged AdminConsentAction = "unchanged"
+)
+
+type AdministrativeUnitMembershipRuleProcessingState = string
+
+const (
+	AdministrativeUnitMembershipRuleProcessingStateOn     AdministrativeUnitMembershipRuleProcessingState = "On"
+	AdministrativeUnitMembershipRuleProcessingStatePaused AdministrativeUnitMembershipRuleProcessingState = "Paused"
+)
+
+type AdministrativeUnitMembershipType = string
+
+const (
+	AdministrativeUnitMembershipTypeAssigned AdministrativeUnitMembershipType = "Assigned"
+	AdministrativeUnitMembershipTypeDynamic  AdministrativeUnitMembershipType = "Dynamic"
+)
+
 type AdministrativeUnitVisibility = string
 
 const (
@@ -158,6 +180,13 @@ const (
 	AdministrativeUnitVisibilityPublic           AdministrativeUnitVisibility = "Public"
 )
 
+type AgreementAcceptanceState = string
+
+const (
+	AgreementAcceptanceStateAccepted AgreementAcceptanceState = "accepted"
+	AgreementAcceptanceStateDeclined AgreementAcceptanceState = "declined"
+)
+
 type AgeGroup = StringNullWhenEmpty
 
 const (
@@ -167,6 +196,27 @@ const (
 	AgeGroupNotAdult AgeGroup = "NotAdult"
 )
 
+type AppCredentialKeyType = string
+
+const (
+	AppCredentialKeyTypeCertificate  AppCredentialKeyType = "certificate"
+	AppCredentialKeyTypeClientSecret AppCredentialKeyType = "clientSecret"
+)
+
+type AppCredentialKeyUsage = string
+
+const (
+	AppCredentialKeyUsageSign   AppCredentialKeyUsage = "sign"
+	AppCredentialKeyUsageVerify AppCredentialKeyUsage = "verify"
+)
+
+type AppCredentialOrigin = string
+
+const (
+	AppCredentialOriginApplication      AppCredentialOrigin = "application"
+	AppCredentialOriginServicePrincipal AppCredentialOrigin = "servicePrincipal"
+)
+
 type ApplicationExtensionDataType = string
 
 const (
@@ -188,6 +238,13 @@ const (
 	ApplicationExtensionTargetObjectUser         ApplicationExtensionTargetObject = "User"
 )
 
+type ApplicationManifestFormat = string
+
+const (
+	ApplicationManifestFormatAadGraph ApplicationManifestFormat = "aadGraph"
+	ApplicationManifestFormatMsGraph  ApplicationManifestFormat = "msGraph"
+)
+
 type ApplicationTemplateCategory = string
 
 const (
@@ -248,6 +305,42 @@ const (
 	AttestationLevelNotAttested AttestationLevel = "notAttested"
 )
 
+type AttributeFlowBehavior = string
+
+const (
+	AttributeFlowBehaviorFlowWhenChanged AttributeFlowBehavior = "FlowWhenChanged"
+	AttributeFlowBehaviorFlowAlways      AttributeFlowBehavior = "FlowAlways"
+)
+
+type AttributeFlowType = string
+
+const (
+	AttributeFlowTypeAlways            AttributeFlowType = "Always"
+	AttributeFlowTypeObjectAddOnly     AttributeFlowType = "ObjectAddOnly"
+	AttributeFlowTypeMultiValueAddOnly AttributeFlowType = "MultiValueAddOnly"
+	AttributeFlowTypeValueAddOnly      AttributeFlowType = "ValueAddOnly"
+	AttributeFlowTypeAttributeAddOnly  AttributeFlowType = "AttributeAddOnly"
+)
+
+type AttributeMappingSourceType = string
+
+const (
+	AttributeMappingSourceTypeAttribute AttributeMappingSourceType = "Attribute"
+	AttributeMappingSourceTypeConstant  AttributeMappingSourceType = "Constant"
+	AttributeMappingSourceTypeFunction  AttributeMappingSourceType = "Function"
+)
+
+type AttributeType = string
+
+const (
+	AttributeTypeString    AttributeType = "String"
+	AttributeTypeInteger   AttributeType = "Integer"
+	AttributeTypeReference AttributeType = "Reference"
+	AttributeTypeBinary    AttributeType = "Binary"
+	AttributeTypeBoolean   AttributeType = "Boolean"
+	AttributeTypeDateTime  AttributeType = "DateTime"
+)
+
 type AuthenticationMethodFeature = string
 
 const (
@@ -288,6 +381,18 @@ const (
 	AuthenticationMethodModesX509CertificateSingleFactor AuthenticationMethodModes = "x509CertificateSingleFactor"
 )
 
+type AuthenticationMethodPlatform = string
+
+const (
+	AuthenticationMethodPlatformUnknown            AuthenticationMethodPlatform = "unknown"
+	AuthenticationMethodPlatformWindows            AuthenticationMethodPlatform = "windows"
+	AuthenticationMethodPlatformMacOS              AuthenticationMethodPlatform = "macOS"
+	AuthenticationMethodPlatformIOS                AuthenticationMethodPlatform = "iOS"
+	AuthenticationMethodPlatformAndroid            AuthenticationMethodPlatform = "android"
+	AuthenticationMethodPlatformLinux              AuthenticationMethodPlatform = "linux"
+	AuthenticationMethodPlatformUnknownFutureValue AuthenticationMethodPlatform = "unknownFutureValue"
+)
+
 type AuthenticationPhoneType = string
 
 const (
@@ -320,6 +425,29 @@ const (
 	ConsentProvidedForMinorNotRequired ConsentProvidedForMinor = "NotRequired"
 )
 
+type CredentialExpiryCredentialType = string
+
+const (
+	CredentialExpiryCredentialTypeCertificate            CredentialExpiryCredentialType = "certificate"
+	CredentialExpiryCredentialTypePassword               CredentialExpiryCredentialType = "password"
+	CredentialExpiryCredentialTypeSamlSigningCertificate CredentialExpiryCredentialType = "samlSigningCertificate"
+)
+
+type CredentialExpiryObjectType = string
+
+const (
+	CredentialExpiryObjectTypeApplication      CredentialExpiryObjectType = "application"
+	CredentialExpiryObjectTypeServicePrincipal CredentialExpiryObjectType = "servicePrincipal"
+)
+
+type CredentialType = string
+
+const (
+	CredentialTypeOther    CredentialType = "other"
+	CredentialTypePassword CredentialType = "password"
+	CredentialTypeText     CredentialType = "text"
+)
+
 type CredentialUsageSummaryPeriod = string
 
 const (
@@ -465,6 +593,30 @@ const (
 	ConnectedOrganizationStateUnknownFutureValue ConnectedOrganizationState = "unknownFutureValue"
 )
 
+type ConnectorGroupRegion = string
+
+const (
+	ConnectorGroupRegionAsia               ConnectorGroupRegion = "asia"
+	ConnectorGroupRegionAus                ConnectorGroupRegion = "aus"
+	ConnectorGroupRegionEur                ConnectorGroupRegion = "eur"
+	ConnectorGroupRegionInd                ConnectorGroupRegion = "ind"
+	ConnectorGroupRegionNam                ConnectorGroupRegion = "nam"
+	ConnectorGroupRegionUnknownFutureValue ConnectorGroupRegion = "unknownFutureValue"
+)
+
+type ConnectorGroupType = string
+
+const (
+	ConnectorGroupTypeApplicationProxy ConnectorGroupType = "applicationProxy"
+)
+
+type ConnectorStatus = string
+
+const (
+	ConnectorStatusActive   ConnectorStatus = "active"
+	ConnectorStatusInactive ConnectorStatus = "inactive"
+)
+
 type DaysOfWeekType = string
 
 const (
@@ -562,6 +714,13 @@ const (
 	ExtensionSchemaPropertyDataString   ExtensionSchemaPropertyDataType = "String"
 )
 
+type ExternalUserState = string
+
+const (
+	ExternalUserStateAccepted          ExternalUserState = "Accepted"
+	ExternalUserStatePendingAcceptance ExternalUserState = "PendingAcceptance"
+)
+
 type FeatureType = string
 
 const (
@@ -570,6 +729,13 @@ const (
 	FeatureTypeUnknownFutureValue FeatureType = "unknownFutureValue"
 )
 
+type FederationMetadataRole = string
+
+const (
+	FederationMetadataRoleIdentityProvider FederationMetadataRole = "identityProvider"
+	FederationMetadataRoleServiceProvider  FederationMetadataRole = "serviceProvider"
+)
+
 type FirstDayOfWeek = string
 
 const (
@@ -582,6 +748,22 @@ const (
 	FirstDayOfWeekSaturday  FirstDayOfWeek = "staturday"
 )
 
+type GitHubActionsEntityType = string
+
+const (
+	GitHubActionsEntityTypeBranch      GitHubActionsEntityType = "branch"
+	GitHubActionsEntityTypeEnvironment GitHubActionsEntityType = "environment"
+	GitHubActionsEntityTypePullRequest GitHubActionsEntityType = "pull_request"
+	GitHubActionsEntityTypeTag         GitHubActionsEntityType = "tag"
+)
+
+type GitLabRefType = string
+
+const (
+	GitLabRefTypeBranch GitLabRefType = "branch"
+	GitLabRefTypeTag    GitLabRefType = "tag"
+)
+
 type GroupMembershipRuleProcessingState = string
 
 const (
@@ -646,6 +828,41 @@ const (
 	GroupVisibilityPublic           GroupVisibility = "Public"
 )
 
+type HardwareOathTokenHashFunction = string
+
+const (
+	HardwareOathTokenHashFunctionHmacSha1   HardwareOathTokenHashFunction = "hmacsha1"
+	HardwareOathTokenHashFunctionHmacSha256 HardwareOathTokenHashFunction = "hmacsha256"
+)
+
+type HardwareOathTokenStatus = string
+
+const (
+	HardwareOathTokenStatusAvailable          HardwareOathTokenStatus = "available"
+	HardwareOathTokenStatusAssigned           HardwareOathTokenStatus = "assigned"
+	HardwareOathTokenStatusActivated          HardwareOathTokenStatus = "activated"
+	HardwareOathTokenStatusFailedActivation   HardwareOathTokenStatus = "failedActivation"
+	HardwareOathTokenStatusUnknownFutureValue HardwareOathTokenStatus = "unknownFutureValue"
+)
+
+type ImportedWindowsAutopilotDeviceIdentityImportStatus = string
+
+const (
+	ImportedWindowsAutopilotDeviceIdentityImportStatusComplete ImportedWindowsAutopilotDeviceIdentityImportStatus = "complete"
+	ImportedWindowsAutopilotDeviceIdentityImportStatusError    ImportedWindowsAutopilotDeviceIdentityImportStatus = "error"
+	ImportedWindowsAutopilotDeviceIdentityImportStatusPartial  ImportedWindowsAutopilotDeviceIdentityImportStatus = "partial"
+	ImportedWindowsAutopilotDeviceIdentityImportStatusPending  ImportedWindowsAutopilotDeviceIdentityImportStatus = "pending"
+	ImportedWindowsAutopilotDeviceIdentityImportStatusUnknown  ImportedWindowsAutopilotDeviceIdentityImportStatus = "unknown"
+)
+
+type InitiatorType = string
+
+const (
+	InitiatorTypeUser   InitiatorType = "user"
+	InitiatorTypeApp    InitiatorType = "app"
+	InitiatorTypeSystem InitiatorType = "system"
+)
+
 type InvitedUserType = string
 
 const (
@@ -675,6 +892,40 @@ const (
 	UniversalSecurityGroup            OnPremisesGroupType = "UniversalSecurityGroup"
 )
 
+type OnPremisesPublishingApplicationServerTimeout = string
+
+const (
+	OnPremisesPublishingApplicationServerTimeoutDefault OnPremisesPublishingApplicationServerTimeout = "Default"
+	OnPremisesPublishingApplicationServerTimeoutLong    OnPremisesPublishingApplicationServerTimeout = "Long"
+)
+
+type OnPremisesPublishingExternalAuthenticationType = string
+
+const (
+	OnPremisesPublishingExternalAuthenticationTypeAadPreAuthentication OnPremisesPublishingExternalAuthenticationType = "aadPreAuthentication"
+	OnPremisesPublishingExternalAuthenticationTypePassthru             OnPremisesPublishingExternalAuthenticationType = "passthru"
+)
+
+type LongRunningOperationStatus = string
+
+const (
+	LongRunningOperationStatusNotStarted         LongRunningOperationStatus = "notStarted"
+	LongRunningOperationStatusRunning            LongRunningOperationStatus = "running"
+	LongRunningOperationStatusSucceeded          LongRunningOperationStatus = "succeeded"
+	LongRunningOperationStatusFailed             LongRunningOperationStatus = "failed"
+	LongRunningOperationStatusUnknownFutureValue LongRunningOperationStatus = "unknownFutureValue"
+)
+
+type MembershipRuleProcessingStatusDetails = string
+
+const (
+	MembershipRuleProcessingStatusDetailsNotStarted         MembershipRuleProcessingStatusDetails = "NotStarted"
+	MembershipRuleProcessingStatusDetailsRunning            MembershipRuleProcessingStatusDetails = "Running"
+	MembershipRuleProcessingStatusDetailsFailed             MembershipRuleProcessingStatusDetails = "Failed"
+	MembershipRuleProcessingStatusDetailsSucceeded          MembershipRuleProcessingStatusDetails = "Succeeded"
+	MembershipRuleProcessingStatusDetailsUnknownFutureValue MembershipRuleProcessingStatusDetails = "UnknownFutureValue"
+)
+
 type Members []DirectoryObject
 
 func (o Members) MarshalJSON() ([]byte, error) {
@@ -709,6 +960,31 @@ const (
 	MethodUsabilityReasonOneTimeUsed      MethodUsabilityReason = "oneTimeUsed"
 )
 
+type Mutability = string
+
+const (
+	MutabilityReadWrite Mutability = "ReadWrite"
+	MutabilityReadOnly  Mutability = "ReadOnly"
+	MutabilityImmutable Mutability = "Immutable"
+	MutabilityWriteOnly Mutability = "WriteOnly"
+)
+
+type OwnerGovernanceFinding = string
+
+const (
+	OwnerGovernanceFindingNoOwners      OwnerGovernanceFinding = "noOwners"
+	OwnerGovernanceFindingDeletedOwner  OwnerGovernanceFinding = "deletedOwner"
+	OwnerGovernanceFindingDisabledOwner OwnerGovernanceFinding = "disabledOwner"
+	OwnerGovernanceFindingGuestOwner    OwnerGovernanceFinding = "guestOwner"
+)
+
+type OwnerGovernanceObjectType = string
+
+const (
+	OwnerGovernanceObjectTypeApplication      OwnerGovernanceObjectType = "application"
+	OwnerGovernanceObjectTypeServicePrincipal OwnerGovernanceObjectType = "servicePrincipal"
+)
+
 type Owners []DirectoryObject
 
 func (o Owners) MarshalJSON() ([]byte, error) {
@@ -747,6 +1023,21 @@ const (
 	PersistentBrowserSessionModeNever  PersistentBrowserSessionMode = "never"
 )
 
+type PhoneType = string
+
+const (
+	PhoneTypeHome        PhoneType = "home"
+	PhoneTypeBusiness    PhoneType = "business"
+	PhoneTypeMobile      PhoneType = "mobile"
+	PhoneTypeOther       PhoneType = "other"
+	PhoneTypeAssistant   PhoneType = "assistant"
+	PhoneTypeHomeFax     PhoneType = "homeFax"
+	PhoneTypeBusinessFax PhoneType = "businessFax"
+	PhoneTypeOtherFax    PhoneType = "otherFax"
+	PhoneTypePager       PhoneType = "pager"
+	PhoneTypeRadio       PhoneType = "radio"
+)
+
 type PreferredSingleSignOnMode = StringNullWhenEmpty
 
 const (
@@ -757,6 +1048,45 @@ const (
 	PreferredSingleSignOnModeSaml         PreferredSingleSignOnMode = "saml"
 )
 
+type ProvisioningAction = string
+
+const (
+	ProvisioningActionOther        ProvisioningAction = "other"
+	ProvisioningActionCreate       ProvisioningAction = "create"
+	ProvisioningActionDelete       ProvisioningAction = "delete"
+	ProvisioningActionDisable      ProvisioningAction = "disable"
+	ProvisioningActionUpdate       ProvisioningAction = "update"
+	ProvisioningActionStagedDelete ProvisioningAction = "stagedDelete"
+)
+
+type ProvisioningResult = string
+
+const (
+	ProvisioningResultSuccess ProvisioningResult = "success"
+	ProvisioningResultFailure ProvisioningResult = "failure"
+	ProvisioningResultSkipped ProvisioningResult = "skipped"
+	ProvisioningResultWarning ProvisioningResult = "warning"
+)
+
+type ProvisioningStatusErrorCategory = string
+
+const (
+	ProvisioningStatusErrorCategoryFailure           ProvisioningStatusErrorCategory = "failure"
+	ProvisioningStatusErrorCategoryNonServiceFailure ProvisioningStatusErrorCategory = "nonServiceFailure"
+	ProvisioningStatusErrorCategorySuccess           ProvisioningStatusErrorCategory = "success"
+)
+
+type ProvisioningStepType = string
+
+const (
+	ProvisioningStepTypeImport              ProvisioningStepType = "import"
+	ProvisioningStepTypeScoping             ProvisioningStepType = "scoping"
+	ProvisioningStepTypeMatching            ProvisioningStepType = "matching"
+	ProvisioningStepTypeProcessing          ProvisioningStepType = "processing"
+	ProvisioningStepTypeReferenceResolution ProvisioningStepType = "referenceResolution"
+	ProvisioningStepTypeExport              ProvisioningStepType = "export"
+)
+
 type PrivilegedAccessGroupAction = string
 
 const (
@@ -825,6 +1155,36 @@ const (
 	PrivilegedAccessGroupRelationshipUnknown PrivilegedAccessGroupRelationship = "unknownFutureValue"
 )
 
+type PrivateNetworkDestinationType = string
+
+const (
+	PrivateNetworkDestinationTypeDnsSuffix   PrivateNetworkDestinationType = "dnsSuffix"
+	PrivateNetworkDestinationTypeFqdn        PrivateNetworkDestinationType = "fqdn"
+	PrivateNetworkDestinationTypeIpAddress   PrivateNetworkDestinationType = "ipAddress"
+	PrivateNetworkDestinationTypeIpRange     PrivateNetworkDestinationType = "ipRange"
+	PrivateNetworkDestinationTypeIpRangeCidr PrivateNetworkDestinationType = "ipRangeCidr"
+)
+
+type PrivateNetworkProtocol = string
+
+const (
+	PrivateNetworkProtocolTcp PrivateNetworkProtocol = "tcp"
+	PrivateNetworkProtocolUdp PrivateNetworkProtocol = "udp"
+)
+
+type QuarantineReason = string
+
+const (
+	QuarantineReasonEncounteredBaseEscrowThreshold       QuarantineReason = "EncounteredBaseEscrowThreshold"
+	QuarantineReasonEncounteredTotalEscrowThreshold      QuarantineReason = "EncounteredTotalEscrowThreshold"
+	QuarantineReasonEncounteredEscrowProportionThreshold QuarantineReason = "EncounteredEscrowProportionThreshold"
+	QuarantineReasonEncounteredQuarantineException       QuarantineReason = "EncounteredQuarantineException"
+	QuarantineReasonQuarantinedOnDemand                  QuarantineReason = "QuarantinedOnDemand"
+	QuarantineReasonTooManyDeletes                       QuarantineReason = "TooManyDeletes"
+	QuarantineReasonIngestionInterrupted                 QuarantineReason = "IngestionInterrupted"
+	QuarantineReasonUnknown                              QuarantineReason = "Unknown"
+)
+
 type RecurrencePatternType = string
 
 const (
@@ -880,6 +1240,20 @@ const (
 	ResourceAccessTypeScope ResourceAccessType = "Scope"
 )
 
+type ScopeOperatorMultiValuedComparisonType = string
+
+const (
+	ScopeOperatorMultiValuedComparisonTypeAll ScopeOperatorMultiValuedComparisonType = "All"
+	ScopeOperatorMultiValuedComparisonTypeAny ScopeOperatorMultiValuedComparisonType = "Any"
+)
+
+type ScopeOperatorType = string
+
+const (
+	ScopeOperatorTypeBinary ScopeOperatorType = "Binary"
+	ScopeOperatorTypeUnary  ScopeOperatorType = "Unary"
+)
+
 type SchemaExtensionStatus = string
 
 const (
@@ -909,6 +1283,52 @@ const (
 	SignInAudiencePersonalMicrosoftAccount           SignInAudience = "PersonalMicrosoftAccount"
 )
 
+type SynchronizationStatusCode = string
+
+const (
+	SynchronizationStatusCodeNotConfigured SynchronizationStatusCode = "NotConfigured"
+	SynchronizationStatusCodeNotRun        SynchronizationStatusCode = "NotRun"
+	SynchronizationStatusCodeActive        SynchronizationStatusCode = "Active"
+	SynchronizationStatusCodePaused        SynchronizationStatusCode = "Paused"
+	SynchronizationStatusCodeQuarantine    SynchronizationStatusCode = "Quarantine"
+)
+
+type SynchronizationTaskExecutionResult = string
+
+const (
+	SynchronizationTaskExecutionResultSucceeded        SynchronizationTaskExecutionResult = "Succeeded"
+	SynchronizationTaskExecutionResultFailed           SynchronizationTaskExecutionResult = "Failed"
+	SynchronizationTaskExecutionResultEntryLevelErrors SynchronizationTaskExecutionResult = "EntryLevelErrors"
+)
+
+type TerraformCloudRunPhase = string
+
+const (
+	TerraformCloudRunPhaseApply TerraformCloudRunPhase = "apply"
+	TerraformCloudRunPhasePlan  TerraformCloudRunPhase = "plan"
+)
+
+type TrustFrameworkKeyStatus = string
+
+const (
+	TrustFrameworkKeyStatusEnabled  TrustFrameworkKeyStatus = "enabled"
+	TrustFrameworkKeyStatusDisabled TrustFrameworkKeyStatus = "disabled"
+)
+
+type TrustFrameworkKeyType = string
+
+const (
+	TrustFrameworkKeyTypeOct TrustFrameworkKeyType = "oct"
+	TrustFrameworkKeyTypeRsa TrustFrameworkKeyType = "rsa"
+)
+
+type TrustFrameworkKeyUse = string
+
+const (
+	TrustFrameworkKeyUseEncryption TrustFrameworkKeyUse = "enc"
+	TrustFrameworkKeyUseSignature  TrustFrameworkKeyUse = "sig"
+)
+
 type UnifiedRoleScheduleRequestAction = string
 
 const (
@@ -1015,6 +1435,25 @@ const (
 	IncludedUserTypesGuest  IncludedUserTypes = "guest"
 )
 
+type UserFlowApiConnectorStep = string
+
+const (
+	UserFlowApiConnectorStepPostAttributeCollection UserFlowApiConnectorStep = "postAttributeCollection"
+	UserFlowApiConnectorStepPostFederationSignup    UserFlowApiConnectorStep = "postFederationSignup"
+	UserFlowApiConnectorStepPreTokenIssuance        UserFlowApiConnectorStep = "preTokenIssuance"
+)
+
+type UserFlowAttributeInputType = string
+
+const (
+	UserFlowAttributeInputTypeCheckboxMultiSelect  UserFlowAttributeInputType = "checkboxMultiSelect"
+	UserFlowAttributeInputTypeDateTimeDropdown     UserFlowAttributeInputType = "dateTimeDropdown"
+	UserFlowAttributeInputTypeDropdownSingleSelect UserFlowAttributeInputType = "dropdownSingleSelect"
+	UserFlowAttributeInputTypeEmailBox             UserFlowAttributeInputType = "emailBox"
+	UserFlowAttributeInputTypeRadioSingleSelect    UserFlowAttributeInputType = "radioSingleSelect"
+	UserFlowAttributeInputTypeTextBox              UserFlowAttributeInputType = "textBox"
+)
+
 type UserflowAttributeDataType = string
 
 const (
@@ -9,8 +9,47 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
 )
 
+// These assignment target types are not yet provided by the odata package
+const (
+	odataTypeAllDevicesAssignmentTarget       odata.Type = "#microsoft.graph.allDevicesAssignmentTarget"
+	odataTypeAllLicensedUsersAssignmentTarget odata.Type = "#microsoft.graph.allLicensedUsersAssignmentTarget"
+	odataTypeExclusionGroupAssignmentTarget   odata.Type = "#microsoft.graph.exclusionGroupAssignmentTarget"
+	odataTypeGroupAssignmentTarget            odata.Type = "#microsoft.graph.groupAssignmentTarget"
+)
+
+// NewAllDevicesAssignmentTarget returns a DeviceAndAppManagementAssignmentTarget targeting all devices.
+func NewAllDevicesAssignmentTarget() *DeviceAndAppManagementAssignmentTarget {
+	return &DeviceAndAppManagementAssignmentTarget{
+		ODataType: utils.StringPtr(odataTypeAllDevicesAssignmentTarget),
+	}
+}
+
+// NewAllLicensedUsersAssignmentTarget returns a DeviceAndAppManagementAssignmentTarget targeting all licensed users.
+func NewAllLicensedUsersAssignmentTarget() *DeviceAndAppManagementAssignmentTarget {
+	return &DeviceAndAppManagementAssignmentTarget{
+		ODataType: utils.StringPtr(odataTypeAllLicensedUsersAssignmentTarget),
+	}
+}
+
+// NewExclusionGroupAssignmentTarget returns a DeviceAndAppManagementAssignmentTarget excluding the members of a group.
+func NewExclusionGroupAssignmentTarget(groupId string) *DeviceAndAppManagementAssignmentTarget {
+	return &DeviceAndAppManagementAssignmentTarget{
+		ODataType: utils.StringPtr(odataTypeExclusionGroupAssignmentTarget),
+		GroupId:   utils.StringPtr(groupId),
+	}
+}
+
+// NewGroupAssignmentTarget returns a DeviceAndAppManagementAssignmentTarget targeting the members of a group.
+func NewGroupAssignmentTarget(groupId string) *DeviceAndAppManagementAssignmentTarget {
+	return &DeviceAndAppManagementAssignmentTarget{
+		ODataType: utils.StringPtr(odataTypeGroupAssignmentTarget),
+		GroupId:   utils.StringPtr(groupId),
+	}
+}
+
 type WindowsAutopilotDeploymentProfilesClient struct {
 	BaseClient Client
 }
@@ -156,3 +195,114 @@ func (c *WindowsAutopilotDeploymentProfilesClient) Delete(ctx context.Context, i
 
 	return status, nil
 }
+
+// ListAssignments returns the assignments for a WindowsAutopilotDeploymentProfile.
+func (c *WindowsAutopilotDeploymentProfilesClient) ListAssignments(ctx context.Context, id string) (*[]WindowsAutopilotDeploymentProfileAssignment, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/windowsAutopilotDeploymentProfiles/%s/assignments", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("WindowsAutopilotDeploymentProfilesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		Assignments []WindowsAutopilotDeploymentProfileAssignment `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.Assignments, status, nil
+}
+
+// GetAssignment retrieves an assignment for a WindowsAutopilotDeploymentProfile.
+func (c *WindowsAutopilotDeploymentProfilesClient) GetAssignment(ctx context.Context, id, assignmentId string) (*WindowsAutopilotDeploymentProfileAssignment, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/windowsAutopilotDeploymentProfiles/%s/assignments/%s", id, assignmentId),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("WindowsAutopilotDeploymentProfilesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var assignment WindowsAutopilotDeploymentProfileAssignment
+	if err := json.Unmarshal(respBody, &assignment); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &assignment, status, nil
+}
+
+// CreateAssignment assigns a WindowsAutopilotDeploymentProfile to a target, such as a group of devices.
+func (c *WindowsAutopilotDeploymentProfilesClient) CreateAssignment(ctx context.Context, id string, assignment WindowsAutopilotDeploymentProfileAssignment) (*WindowsAutopilotDeploymentProfileAssignment, int, error) {
+	var status int
+
+	if assignment.Target == nil {
+		return nil, status, errors.New("cannot create WindowsAutopilotDeploymentProfileAssignment with nil Target")
+	}
+
+	body, err := json.Marshal(assignment)
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusCreated},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/windowsAutopilotDeploymentProfiles/%s/assignments", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("WindowsAutopilotDeploymentProfilesClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var newAssignment WindowsAutopilotDeploymentProfileAssignment
+	if err := json.Unmarshal(respBody, &newAssignment); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &newAssignment, status, nil
+}
+
+// DeleteAssignment removes an assignment for a WindowsAutopilotDeploymentProfile.
+func (c *WindowsAutopilotDeploymentProfilesClient) DeleteAssignment(ctx context.Context, id, assignmentId string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/windowsAutopilotDeploymentProfiles/%s/assignments/%s", id, assignmentId),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("WindowsAutopilotDeploymentProfilesClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
@@ -54,6 +54,14 @@ func TestWindowsAutopilotDeploymentProfilesClient(t *testing.T) {
 
 	testWindowsAutopilotDeploymentProfilesClient_List(t, c)
 	testWindowsAutopilotDeploymentProfilesClient_Get(t, c, *profile.ID)
+
+	assignment := testWindowsAutopilotDeploymentProfilesClient_CreateAssignment(t, c, *profile.ID, msgraph.WindowsAutopilotDeploymentProfileAssignment{
+		Target: msgraph.NewAllDevicesAssignmentTarget(),
+	})
+	testWindowsAutopilotDeploymentProfilesClient_ListAssignments(t, c, *profile.ID)
+	testWindowsAutopilotDeploymentProfilesClient_GetAssignment(t, c, *profile.ID, *assignment.ID)
+	testWindowsAutopilotDeploymentProfilesClient_DeleteAssignment(t, c, *profile.ID, *assignment.ID)
+
 	testWindowsAutopilotDeploymentProfilesClient_Delete(t, c, *profile.ID)
 }
 
@@ -118,3 +126,55 @@ func testWindowsAutopilotDeploymentProfilesClient_Delete(t *testing.T, c *test.T
 		t.Fatalf("WindowsAutopilotDeploymentProfilesClient.Delete(): invalid status: %d", status)
 	}
 }
+
+func testWindowsAutopilotDeploymentProfilesClient_CreateAssignment(t *testing.T, c *test.Test, id string, assignment msgraph.WindowsAutopilotDeploymentProfileAssignment) (newAssignment *msgraph.WindowsAutopilotDeploymentProfileAssignment) {
+	newAssignment, status, err := c.WindowsAutopilotDeploymentProfilesClient.CreateAssignment(c.Context, id, assignment)
+	if err != nil {
+		t.Fatalf("WindowsAutopilotDeploymentProfilesClient.CreateAssignment(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("WindowsAutopilotDeploymentProfilesClient.CreateAssignment(): invalid status: %d", status)
+	}
+	if newAssignment == nil {
+		t.Fatal("WindowsAutopilotDeploymentProfilesClient.CreateAssignment(): assignment was nil")
+	}
+	if newAssignment.ID == nil {
+		t.Fatal("WindowsAutopilotDeploymentProfilesClient.CreateAssignment(): assignment.ID was nil")
+	}
+	return
+}
+
+func testWindowsAutopilotDeploymentProfilesClient_ListAssignments(t *testing.T, c *test.Test, id string) (assignments *[]msgraph.WindowsAutopilotDeploymentProfileAssignment) {
+	assignments, _, err := c.WindowsAutopilotDeploymentProfilesClient.ListAssignments(c.Context, id)
+	if err != nil {
+		t.Fatalf("WindowsAutopilotDeploymentProfilesClient.ListAssignments(): %v", err)
+	}
+	if assignments == nil {
+		t.Fatal("WindowsAutopilotDeploymentProfilesClient.ListAssignments(): assignments was nil")
+	}
+	return
+}
+
+func testWindowsAutopilotDeploymentProfilesClient_GetAssignment(t *testing.T, c *test.Test, id, assignmentId string) (assignment *msgraph.WindowsAutopilotDeploymentProfileAssignment) {
+	assignment, status, err := c.WindowsAutopilotDeploymentProfilesClient.GetAssignment(c.Context, id, assignmentId)
+	if err != nil {
+		t.Fatalf("WindowsAutopilotDeploymentProfilesClient.GetAssignment(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("WindowsAutopilotDeploymentProfilesClient.GetAssignment(): invalid status: %d", status)
+	}
+	if assignment == nil {
+		t.Fatal("WindowsAutopilotDeploymentProfilesClient.GetAssignment(): assignment was nil")
+	}
+	return
+}
+
+func testWindowsAutopilotDeploymentProfilesClient_DeleteAssignment(t *testing.T, c *test.Test, id, assignmentId string) {
+	status, err := c.WindowsAutopilotDeploymentProfilesClient.DeleteAssignment(c.Context, id, assignmentId)
+	if err != nil {
+		t.Fatalf("WindowsAutopilotDeploymentProfilesClient.DeleteAssignment(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("WindowsAutopilotDeploymentProfilesClient.DeleteAssignment(): invalid status: %d", status)
+	}
+}
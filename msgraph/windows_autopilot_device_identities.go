@@ -0,0 +1,195 @@
+package msgraph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// WindowsAutopilotDeviceIdentitiesClient performs operations on devices registered with Windows Autopilot.
+type WindowsAutopilotDeviceIdentitiesClient struct {
+	BaseClient Client
+}
+
+// NewWindowsAutopilotDeviceIdentitiesClient returns a new WindowsAutopilotDeviceIdentitiesClient.
+func NewWindowsAutopilotDeviceIdentitiesClient() *WindowsAutopilotDeviceIdentitiesClient {
+	return &WindowsAutopilotDeviceIdentitiesClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// List returns a list of Windows Autopilot device identities, optionally queried using OData.
+func (c *WindowsAutopilotDeviceIdentitiesClient) List(ctx context.Context, query odata.Query) (*[]WindowsAutopilotDeviceIdentity, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/deviceManagement/windowsAutopilotDeviceIdentities",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("WindowsAutopilotDeviceIdentitiesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		DeviceIdentities []WindowsAutopilotDeviceIdentity `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.DeviceIdentities, status, nil
+}
+
+// Get retrieves a Windows Autopilot device identity.
+func (c *WindowsAutopilotDeviceIdentitiesClient) Get(ctx context.Context, id string, query odata.Query) (*WindowsAutopilotDeviceIdentity, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		OData:                  query,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/windowsAutopilotDeviceIdentities/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("WindowsAutopilotDeviceIdentitiesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var deviceIdentity WindowsAutopilotDeviceIdentity
+	if err := json.Unmarshal(respBody, &deviceIdentity); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &deviceIdentity, status, nil
+}
+
+// Delete removes a device from Windows Autopilot.
+func (c *WindowsAutopilotDeviceIdentitiesClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/windowsAutopilotDeviceIdentities/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("WindowsAutopilotDeviceIdentitiesClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// AssignUserToDevice assigns a user to a Windows Autopilot device, so that the user is not prompted for their
+// credentials during setup. addressableUserName is the name displayed to the user, e.g. their first name.
+func (c *WindowsAutopilotDeviceIdentitiesClient) AssignUserToDevice(ctx context.Context, id, userPrincipalName, addressableUserName string) (int, error) {
+	var status int
+
+	if userPrincipalName == "" {
+		return status, errors.New("cannot assign user to device with empty userPrincipalName")
+	}
+
+	body, err := json.Marshal(struct {
+		UserPrincipalName   string `json:"userPrincipalName"`
+		AddressableUserName string `json:"addressableUserName,omitempty"`
+	}{
+		UserPrincipalName:   userPrincipalName,
+		AddressableUserName: addressableUserName,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/windowsAutopilotDeviceIdentities/%s/assignUserToDevice", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("WindowsAutopilotDeviceIdentitiesClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// UnassignUserFromDevice removes the assigned user from a Windows Autopilot device.
+func (c *WindowsAutopilotDeviceIdentitiesClient) UnassignUserFromDevice(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/windowsAutopilotDeviceIdentities/%s/unassignUserFromDevice", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("WindowsAutopilotDeviceIdentitiesClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
+
+// UpdateDeviceProperties amends the properties of a Windows Autopilot device. Only the UserPrincipalName,
+// AddressableUserName, GroupTag, DisplayName, DeviceAccountUpn, DeviceAccountPassword and DeviceFriendlyName fields can
+// be updated, other fields are ignored.
+func (c *WindowsAutopilotDeviceIdentitiesClient) UpdateDeviceProperties(ctx context.Context, deviceIdentity WindowsAutopilotDeviceIdentity) (int, error) {
+	var status int
+
+	if deviceIdentity.ID == nil {
+		return status, errors.New("cannot update WindowsAutopilotDeviceIdentity with nil ID")
+	}
+
+	body, err := json.Marshal(struct {
+		UserPrincipalName     *string `json:"userPrincipalName,omitempty"`
+		AddressableUserName   *string `json:"addressableUserName,omitempty"`
+		GroupTag              *string `json:"groupTag,omitempty"`
+		DisplayName           *string `json:"displayName,omitempty"`
+		DeviceAccountUpn      *string `json:"deviceAccountUpn,omitempty"`
+		DeviceAccountPassword *string `json:"deviceAccountPassword,omitempty"`
+		DeviceFriendlyName    *string `json:"deviceFriendlyName,omitempty"`
+	}{
+		UserPrincipalName:     deviceIdentity.UserPrincipalName,
+		AddressableUserName:   deviceIdentity.AddressableUserName,
+		GroupTag:              deviceIdentity.GroupTag,
+		DisplayName:           deviceIdentity.DisplayName,
+		DeviceAccountUpn:      deviceIdentity.DeviceAccountUpn,
+		DeviceAccountPassword: deviceIdentity.DeviceAccountPassword,
+		DeviceFriendlyName:    deviceIdentity.DeviceFriendlyName,
+	})
+	if err != nil {
+		return status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	_, status, _, err = c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:                   body,
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/windowsAutopilotDeviceIdentities/%s/updateDeviceProperties", *deviceIdentity.ID),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("WindowsAutopilotDeviceIdentitiesClient.BaseClient.Post(): %v", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,59 @@
+package msgraph_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+
+	"github.com/manicminer/hamilton/internal/test"
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestWindowsAutopilotDeviceIdentitiesClient(t *testing.T) {
+	c := test.NewTest(t)
+	defer c.CancelFunc()
+
+	// Registering devices requires genuine hardware hashes, so only existing devices are inspected here
+	devices := testWindowsAutopilotDeviceIdentitiesClient_List(t, c)
+	if len(*devices) > 0 {
+		testWindowsAutopilotDeviceIdentitiesClient_Get(t, c, *(*devices)[0].ID)
+	}
+
+	testImportedWindowsAutopilotDeviceIdentitiesClient_List(t, c)
+}
+
+func testWindowsAutopilotDeviceIdentitiesClient_List(t *testing.T, c *test.Test) (devices *[]msgraph.WindowsAutopilotDeviceIdentity) {
+	devices, _, err := c.WindowsAutopilotDeviceIdentitiesClient.List(c.Context, odata.Query{Top: 10})
+	if err != nil {
+		t.Fatalf("WindowsAutopilotDeviceIdentitiesClient.List(): %v", err)
+	}
+	if devices == nil {
+		t.Fatal("WindowsAutopilotDeviceIdentitiesClient.List(): devices was nil")
+	}
+	return
+}
+
+func testWindowsAutopilotDeviceIdentitiesClient_Get(t *testing.T, c *test.Test, id string) (device *msgraph.WindowsAutopilotDeviceIdentity) {
+	device, status, err := c.WindowsAutopilotDeviceIdentitiesClient.Get(c.Context, id, odata.Query{})
+	if err != nil {
+		t.Fatalf("WindowsAutopilotDeviceIdentitiesClient.Get(): %v", err)
+	}
+	if status < 200 || status >= 300 {
+		t.Fatalf("WindowsAutopilotDeviceIdentitiesClient.Get(): invalid status: %d", status)
+	}
+	if device == nil {
+		t.Fatal("WindowsAutopilotDeviceIdentitiesClient.Get(): device was nil")
+	}
+	return
+}
+
+func testImportedWindowsAutopilotDeviceIdentitiesClient_List(t *testing.T, c *test.Test) (devices *[]msgraph.ImportedWindowsAutopilotDeviceIdentity) {
+	devices, _, err := c.ImportedWindowsAutopilotDeviceIdentitiesClient.List(c.Context, odata.Query{Top: 10})
+	if err != nil {
+		t.Fatalf("ImportedWindowsAutopilotDeviceIdentitiesClient.List(): %v", err)
+	}
+	if devices == nil {
+		t.Fatal("ImportedWindowsAutopilotDeviceIdentitiesClient.List(): devices was nil")
+	}
+	return
+}
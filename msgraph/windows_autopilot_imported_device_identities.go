@@ -0,0 +1,256 @@
+package msgraph
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/manicminer/hamilton/internal/utils"
+)
+
+// ImportedWindowsAutopilotDeviceIdentitiesClient performs operations on devices being imported into Windows Autopilot.
+type ImportedWindowsAutopilotDeviceIdentitiesClient struct {
+	BaseClient Client
+}
+
+// NewImportedWindowsAutopilotDeviceIdentitiesClient returns a new ImportedWindowsAutopilotDeviceIdentitiesClient.
+func NewImportedWindowsAutopilotDeviceIdentitiesClient() *ImportedWindowsAutopilotDeviceIdentitiesClient {
+	return &ImportedWindowsAutopilotDeviceIdentitiesClient{
+		BaseClient: NewClient(VersionBeta),
+	}
+}
+
+// List returns a list of imported Windows Autopilot device identities, optionally queried using OData.
+func (c *ImportedWindowsAutopilotDeviceIdentitiesClient) List(ctx context.Context, query odata.Query) (*[]ImportedWindowsAutopilotDeviceIdentity, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		DisablePaging:    query.Top > 0,
+		OData:            query,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/deviceManagement/importedWindowsAutopilotDeviceIdentities",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ImportedWindowsAutopilotDeviceIdentitiesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		DeviceIdentities []ImportedWindowsAutopilotDeviceIdentity `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.DeviceIdentities, status, nil
+}
+
+// Get retrieves an imported Windows Autopilot device identity, including the current import status.
+func (c *ImportedWindowsAutopilotDeviceIdentitiesClient) Get(ctx context.Context, id string) (*ImportedWindowsAutopilotDeviceIdentity, int, error) {
+	resp, status, _, err := c.BaseClient.Get(ctx, GetHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/importedWindowsAutopilotDeviceIdentities/%s", id),
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ImportedWindowsAutopilotDeviceIdentitiesClient.BaseClient.Get(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var deviceIdentity ImportedWindowsAutopilotDeviceIdentity
+	if err := json.Unmarshal(respBody, &deviceIdentity); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &deviceIdentity, status, nil
+}
+
+// Import submits devices to be imported into Windows Autopilot. Importing is asynchronous, use WaitForImport() to
+// wait for the import to complete.
+func (c *ImportedWindowsAutopilotDeviceIdentitiesClient) Import(ctx context.Context, deviceIdentities []ImportedWindowsAutopilotDeviceIdentity) (*[]ImportedWindowsAutopilotDeviceIdentity, int, error) {
+	var status int
+
+	if len(deviceIdentities) == 0 {
+		return nil, status, errors.New("no device identities specified")
+	}
+
+	body, err := json.Marshal(struct {
+		DeviceIdentities []ImportedWindowsAutopilotDeviceIdentity `json:"importedWindowsAutopilotDeviceIdentities"`
+	}{
+		DeviceIdentities: deviceIdentities,
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("json.Marshal(): %v", err)
+	}
+
+	resp, status, _, err := c.BaseClient.Post(ctx, PostHttpRequestInput{
+		Body:             body,
+		ValidStatusCodes: []int{http.StatusOK},
+		Uri: Uri{
+			Entity: "/deviceManagement/importedWindowsAutopilotDeviceIdentities/import",
+		},
+	})
+	if err != nil {
+		return nil, status, fmt.Errorf("ImportedWindowsAutopilotDeviceIdentitiesClient.BaseClient.Post(): %v", err)
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status, fmt.Errorf("io.ReadAll(): %v", err)
+	}
+
+	var data struct {
+		DeviceIdentities []ImportedWindowsAutopilotDeviceIdentity `json:"value"`
+	}
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return nil, status, fmt.Errorf("json.Unmarshal(): %v", err)
+	}
+
+	return &data.DeviceIdentities, status, nil
+}
+
+// Delete removes an imported Windows Autopilot device identity. This does not remove the device from Windows Autopilot
+// once it has been successfully imported.
+func (c *ImportedWindowsAutopilotDeviceIdentitiesClient) Delete(ctx context.Context, id string) (int, error) {
+	_, status, _, err := c.BaseClient.Delete(ctx, DeleteHttpRequestInput{
+		ConsistencyFailureFunc: RetryOn404ConsistencyFailureFunc,
+		ValidStatusCodes:       []int{http.StatusOK, http.StatusNoContent},
+		Uri: Uri{
+			Entity: fmt.Sprintf("/deviceManagement/importedWindowsAutopilotDeviceIdentities/%s", id),
+		},
+	})
+	if err != nil {
+		return status, fmt.Errorf("ImportedWindowsAutopilotDeviceIdentitiesClient.BaseClient.Delete(): %v", err)
+	}
+
+	return status, nil
+}
+
+// WaitForImport polls the specified imported device identities at the specified interval, until each has either
+// completed or failed to import, and returns their final state. Devices which failed to import have a
+// DeviceImportStatus of `error`, with the reason in DeviceErrorName. Returns an error if ctx is cancelled first.
+func (c *ImportedWindowsAutopilotDeviceIdentitiesClient) WaitForImport(ctx context.Context, ids []string, interval time.Duration) (*[]ImportedWindowsAutopilotDeviceIdentity, int, error) {
+	var status int
+
+	if interval <= 0 {
+		return nil, status, fmt.Errorf("cannot wait for import with non-positive interval %s", interval)
+	}
+
+	results := make(map[string]ImportedWindowsAutopilotDeviceIdentity, len(ids))
+
+	for {
+		for _, id := range ids {
+			if _, ok := results[id]; ok {
+				continue
+			}
+
+			var deviceIdentity *ImportedWindowsAutopilotDeviceIdentity
+			var err error
+			deviceIdentity, status, err = c.Get(ctx, id)
+			if err != nil {
+				return nil, status, err
+			}
+			if deviceIdentity.State == nil || deviceIdentity.State.DeviceImportStatus == nil {
+				continue
+			}
+			switch *deviceIdentity.State.DeviceImportStatus {
+			case ImportedWindowsAutopilotDeviceIdentityImportStatusComplete, ImportedWindowsAutopilotDeviceIdentityImportStatusError:
+				results[id] = *deviceIdentity
+			}
+		}
+
+		if len(results) == len(ids) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, status, fmt.Errorf("waiting for import of %d Windows Autopilot devices: %v", len(ids)-len(results), ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+
+	ret := make([]ImportedWindowsAutopilotDeviceIdentity, 0, len(ids))
+	for _, id := range ids {
+		ret = append(ret, results[id])
+	}
+
+	return &ret, status, nil
+}
+
+// ParseWindowsAutopilotHardwareHashCsv parses a hardware hash CSV file, as produced by the Get-WindowsAutopilotInfo
+// script, returning device identities ready to be imported. The `Device Serial Number` and `Hardware Hash` columns are
+// required, and the `Windows Product ID`, `Group Tag` and `Assigned User` columns are used when present.
+func ParseWindowsAutopilotHardwareHashCsv(data []byte) ([]ImportedWindowsAutopilotDeviceIdentity, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("CSV contains no header row")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"device serial number", "hardware hash"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV is missing required column %q", required)
+		}
+	}
+
+	value := func(record []string, column string) *string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			if v := strings.TrimSpace(record[i]); v != "" {
+				return utils.StringPtr(v)
+			}
+		}
+		return nil
+	}
+
+	ret := make([]ImportedWindowsAutopilotDeviceIdentity, 0, len(records)-1)
+	for n, record := range records[1:] {
+		deviceIdentity := ImportedWindowsAutopilotDeviceIdentity{
+			SerialNumber:              value(record, "device serial number"),
+			HardwareIdentifier:        value(record, "hardware hash"),
+			ProductKey:                value(record, "windows product id"),
+			GroupTag:                  value(record, "group tag"),
+			AssignedUserPrincipalName: value(record, "assigned user"),
+		}
+		if deviceIdentity.SerialNumber == nil && deviceIdentity.HardwareIdentifier == nil {
+			continue
+		}
+		if deviceIdentity.SerialNumber == nil || deviceIdentity.HardwareIdentifier == nil {
+			return nil, fmt.Errorf("CSV row %d is missing a serial number or hardware hash", n+2)
+		}
+		ret = append(ret, deviceIdentity)
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,46 @@
+package msgraph_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/manicminer/hamilton/msgraph"
+)
+
+func TestParseWindowsAutopilotHardwareHashCsv(t *testing.T) {
+	data := []byte("\xef\xbb\xbfDevice Serial Number,Windows Product ID,Hardware Hash,Group Tag\r\n" +
+		"SN-0001,,VGVzdEhhc2gx,Kiosk\r\n" +
+		"SN-0002,00330-80000-00000-AA123,VGVzdEhhc2gy,\r\n" +
+		",,,\r\n")
+
+	devices, err := msgraph.ParseWindowsAutopilotHardwareHashCsv(data)
+	if err != nil {
+		t.Fatalf("ParseWindowsAutopilotHardwareHashCsv(): %v", err)
+	}
+	if len(devices) != 2 {
+		t.Fatalf("ParseWindowsAutopilotHardwareHashCsv(): expected 2 devices, got %d", len(devices))
+	}
+	if devices[0].SerialNumber == nil || *devices[0].SerialNumber != "SN-0001" {
+		t.Errorf("ParseWindowsAutopilotHardwareHashCsv(): unexpected SerialNumber for first device: %v", devices[0].SerialNumber)
+	}
+	if devices[0].GroupTag == nil || *devices[0].GroupTag != "Kiosk" {
+		t.Errorf("ParseWindowsAutopilotHardwareHashCsv(): unexpected GroupTag for first device: %v", devices[0].GroupTag)
+	}
+	if devices[0].ProductKey != nil {
+		t.Errorf("ParseWindowsAutopilotHardwareHashCsv(): expected nil ProductKey for first device, got %q", *devices[0].ProductKey)
+	}
+	if devices[1].HardwareIdentifier == nil || *devices[1].HardwareIdentifier != "VGVzdEhhc2gy" {
+		t.Errorf("ParseWindowsAutopilotHardwareHashCsv(): unexpected HardwareIdentifier for second device: %v", devices[1].HardwareIdentifier)
+	}
+
+	if _, err := msgraph.ParseWindowsAutopilotHardwareHashCsv([]byte("Device Serial Number,Windows Product ID\r\nSN-0001,\r\n")); err == nil {
+		t.Error("ParseWindowsAutopilotHardwareHashCsv(): expected error for missing Hardware Hash column")
+	}
+}
+
+func TestImportedWindowsAutopilotDeviceIdentitiesClient_WaitForImportInterval(t *testing.T) {
+	client := msgraph.NewImportedWindowsAutopilotDeviceIdentitiesClient()
+	if _, _, err := client.WaitForImport(context.Background(), []string{"11111111-1111-1111-1111-111111111111"}, 0); err == nil {
+		t.Fatal("ImportedWindowsAutopilotDeviceIdentitiesClient.WaitForImport(): expected error for non-positive interval")
+	}
+}